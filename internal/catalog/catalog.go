@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package catalog builds a machine-readable inventory of the provider's resources and data sources.
+// The catalog is derived from the service packages' annotation-generated registrations, the resources' schemas and the service data in names/data.
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+const (
+	ImplementationFramework = "framework" // Terraform Plugin Framework
+	ImplementationSDK       = "sdk"       // Terraform Plugin SDK v2
+)
+
+// Catalog lists the provider's resources and data sources.
+type Catalog struct {
+	DataSources     []Entry `json:"data_sources"`
+	ProviderVersion string  `json:"provider_version"`
+	Resources       []Entry `json:"resources"`
+}
+
+// Entry describes a resource or data source.
+type Entry struct {
+	TypeName                string `json:"type_name"`   // Terraform type name, e.g. "aws_sqs_queue"
+	Name                    string `json:"name"`        // Friendly name, e.g. "Queue"
+	Service                 string `json:"service"`     // Service package name, e.g. "sqs"
+	Subcategory             string `json:"subcategory"` // Documentation subcategory, e.g. "SQS (Simple Queue)"
+	Implementation          string `json:"implementation"`
+	Importable              bool   `json:"importable"` // Always false for data sources
+	Tags                    bool   `json:"tags"`       // Whether transparent tagging is supported
+	TagsIdentifierAttribute string `json:"tags_identifier_attribute,omitempty"`
+	Deprecated              bool   `json:"deprecated"`
+	DeprecationMessage      string `json:"deprecation_message,omitempty"`
+}
+
+// New returns the catalog of the provider's resources and data sources, sorted by type name.
+func New(ctx context.Context) (*Catalog, error) {
+	p, err := provider.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, ok := p.Meta().(*conns.AWSClient)
+	if !ok {
+		return nil, fmt.Errorf("unexpected provider Meta type: %T", p.Meta())
+	}
+
+	subcategories, err := serviceSubcategories()
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := &Catalog{
+		ProviderVersion: version.ProviderVersion,
+	}
+
+	for servicePackageName, sp := range meta.ServicePackages {
+		subcategory := subcategories[servicePackageName]
+
+		for _, v := range sp.SDKDataSources(ctx) {
+			entry := newEntry(servicePackageName, subcategory, ImplementationSDK, v.TypeName, v.Name, v.Tags)
+			if r, ok := p.DataSourcesMap[v.TypeName]; ok {
+				entry.setDeprecation(r.DeprecationMessage)
+			}
+
+			catalog.DataSources = append(catalog.DataSources, entry)
+		}
+
+		for _, v := range sp.FrameworkDataSources(ctx) {
+			ds, err := v.Factory(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var metadataResponse fwdatasource.MetadataResponse
+			ds.Metadata(ctx, fwdatasource.MetadataRequest{}, &metadataResponse)
+			var schemaResponse fwdatasource.SchemaResponse
+			ds.Schema(ctx, fwdatasource.SchemaRequest{}, &schemaResponse)
+
+			entry := newEntry(servicePackageName, subcategory, ImplementationFramework, metadataResponse.TypeName, v.Name, v.Tags)
+			entry.setDeprecation(schemaResponse.Schema.DeprecationMessage)
+
+			catalog.DataSources = append(catalog.DataSources, entry)
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			entry := newEntry(servicePackageName, subcategory, ImplementationSDK, v.TypeName, v.Name, v.Tags)
+			if r, ok := p.ResourcesMap[v.TypeName]; ok {
+				entry.Importable = r.Importer != nil
+				entry.setDeprecation(r.DeprecationMessage)
+			}
+
+			catalog.Resources = append(catalog.Resources, entry)
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var metadataResponse fwresource.MetadataResponse
+			r.Metadata(ctx, fwresource.MetadataRequest{}, &metadataResponse)
+			var schemaResponse fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+
+			entry := newEntry(servicePackageName, subcategory, ImplementationFramework, metadataResponse.TypeName, v.Name, v.Tags)
+			_, entry.Importable = r.(fwresource.ResourceWithImportState)
+			entry.setDeprecation(schemaResponse.Schema.DeprecationMessage)
+
+			catalog.Resources = append(catalog.Resources, entry)
+		}
+	}
+
+	compare := func(a, b Entry) int {
+		return strings.Compare(a.TypeName, b.TypeName)
+	}
+	slices.SortFunc(catalog.DataSources, compare)
+	slices.SortFunc(catalog.Resources, compare)
+
+	return catalog, nil
+}
+
+// Write writes the catalog as indented JSON.
+func (c *Catalog) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(c)
+}
+
+func newEntry(servicePackageName, subcategory, implementation, typeName, name string, tags *types.ServicePackageResourceTags) Entry {
+	entry := Entry{
+		Implementation: implementation,
+		Name:           name,
+		Service:        servicePackageName,
+		Subcategory:    subcategory,
+		TypeName:       typeName,
+	}
+
+	if tags != nil {
+		entry.Tags = true
+		entry.TagsIdentifierAttribute = tags.IdentifierAttribute
+	}
+
+	return entry
+}
+
+func (e *Entry) setDeprecation(message string) {
+	e.Deprecated = message != ""
+	e.DeprecationMessage = message
+}
+
+// serviceSubcategories returns the documentation subcategory of each service package.
+func serviceSubcategories() (map[string]string, error) {
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil, fmt.Errorf("reading service data: %w", err)
+	}
+
+	subcategories := make(map[string]string)
+	for _, v := range serviceData {
+		if v.NotImplemented() {
+			continue
+		}
+
+		subcategories[v.ProviderPackage()] = v.HumanFriendly()
+	}
+
+	return subcategories, nil
+}
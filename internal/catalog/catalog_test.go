@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/catalog"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := catalog.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compare := func(a, b catalog.Entry) int {
+		return strings.Compare(a.TypeName, b.TypeName)
+	}
+	if !slices.IsSortedFunc(c.DataSources, compare) {
+		t.Error("data sources are not sorted by type name")
+	}
+	if !slices.IsSortedFunc(c.Resources, compare) {
+		t.Error("resources are not sorted by type name")
+	}
+
+	find := func(entries []catalog.Entry, typeName string) catalog.Entry {
+		t.Helper()
+
+		i := slices.IndexFunc(entries, func(e catalog.Entry) bool {
+			return e.TypeName == typeName
+		})
+		if i == -1 {
+			t.Fatalf("%s not found in catalog", typeName)
+		}
+
+		return entries[i]
+	}
+
+	testCases := map[string]struct {
+		entries  []catalog.Entry
+		expected catalog.Entry
+	}{
+		"SDK resource": {
+			entries: c.Resources,
+			expected: catalog.Entry{
+				TypeName:                "aws_sqs_queue",
+				Name:                    "Queue",
+				Service:                 "sqs",
+				Subcategory:             "SQS (Simple Queue)",
+				Implementation:          catalog.ImplementationSDK,
+				Importable:              true,
+				Tags:                    true,
+				TagsIdentifierAttribute: "id",
+			},
+		},
+		"SDK data source": {
+			entries: c.DataSources,
+			expected: catalog.Entry{
+				TypeName:       "aws_sqs_queue",
+				Service:        "sqs",
+				Subcategory:    "SQS (Simple Queue)",
+				Implementation: catalog.ImplementationSDK,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(find(testCase.entries, testCase.expected.TypeName), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+
+	if i := slices.IndexFunc(c.Resources, func(e catalog.Entry) bool {
+		return e.Implementation == catalog.ImplementationFramework
+	}); i == -1 {
+		t.Error("no Terraform Plugin Framework resources found in catalog")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	c := &catalog.Catalog{
+		ProviderVersion: "5.0.0",
+		Resources: []catalog.Entry{
+			{
+				TypeName:       "aws_example_thing",
+				Name:           "Thing",
+				Service:        "example",
+				Implementation: catalog.ImplementationFramework,
+				Importable:     true,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var got catalog.Catalog
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(&got, c); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -30,12 +30,13 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
+	AccountID          string
+	DefaultTagsConfig  *tftags.DefaultConfig
+	IgnoreTagsConfig   *tftags.IgnoreConfig
+	Partition          string
+	Region             string
+	RequiredTagsConfig *tftags.RequiredConfig
+	ServicePackages    map[string]ServicePackage
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
@@ -45,11 +46,14 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	rateLimiters              map[string]*serviceRateLimiter // From provider configuration.
+	regionalClients           map[string]*AWSClient          // AWSClients for per-resource Region overrides.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	terraformWorkspace        string
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -105,7 +109,94 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.AwsRegion(ctx), c.DNSSuffix(ctx))
+}
+
+// AwsRegion returns the AWS Region that API calls made with the specified Context are sent to.
+// This is the resource's `region` override, if any, otherwise the provider's configured Region.
+func (c *AWSClient) AwsRegion(ctx context.Context) string { // nosemgrep:ci.aws-in-func-name
+	if region := overrideRegion(ctx, c); region != "" {
+		return region
+	}
+	return c.Region
+}
+
+// overrideRegion returns any per-resource AWS Region override in Context.
+// An empty string is returned if the override is the provider's configured Region.
+func overrideRegion(ctx context.Context, c *AWSClient) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.Region != c.Region {
+		return inContext.Region
+	}
+	return ""
+}
+
+// ForRegion returns an AWSClient for the specified AWS Region.
+// The returned AWSClient's Region is the specified Region, so its API clients and any values derived from its Region, e.g. ARNs, are consistent.
+// It is used for resources and data sources with a per-resource `region` override.
+// The AWSClient itself is returned if the specified Region is empty or is the configured Region.
+func (c *AWSClient) ForRegion(region string) *AWSClient {
+	if region == "" || region == c.Region {
+		return c
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if v, ok := c.regionalClients[region]; ok {
+		return v
+	}
+
+	client := &AWSClient{
+		AccountID:          c.AccountID,
+		DefaultTagsConfig:  c.DefaultTagsConfig,
+		IgnoreTagsConfig:   c.IgnoreTagsConfig,
+		Partition:          c.Partition,
+		Region:             region,
+		RequiredTagsConfig: c.RequiredTagsConfig,
+		ServicePackages:    c.ServicePackages,
+
+		clients:                   make(map[string]any, 0),
+		conns:                     make(map[string]any, 0),
+		dnsSuffix:                 c.dnsSuffix,
+		endpoints:                 c.endpoints,
+		httpClient:                c.httpClient,
+		logger:                    c.logger,
+		rateLimiters:              c.rateLimiters,
+		s3UsePathStyle:            c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint: c.s3USEast1RegionalEndpoint,
+		stsRegion:                 c.stsRegion,
+		terraformWorkspace:        c.terraformWorkspace,
+	}
+	if c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		client.awsConfig = &cfg
+	}
+	if c.session != nil {
+		client.session = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = client
+
+	return client
+}
+
+// SetRegionInContext sets the per-resource AWS Region override in Context.
+// Any placeholders in the resource's default tag values, e.g. `${region}`, are resolved for the overriding Region.
+func (c *AWSClient) SetRegionInContext(ctx context.Context, region string) {
+	inContext, ok := FromContext(ctx)
+	if !ok || region == "" {
+		return
+	}
+
+	inContext.Region = region
+
+	if tagsInContext, ok := tftags.FromContext(ctx); ok && inContext.TypeName != "" {
+		tagsInContext.DefaultConfig = c.ForRegion(region).DefaultTagsConfigForResource(inContext.ServicePackageName, inContext.TypeName)
+	}
 }
 
 // RDSConnForRegion returns an AWS SDK For Go v1 RDS API client for the specified AWS Region.
@@ -122,6 +213,7 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
+	c = c.ForRegion(overrideRegion(ctx, c))
 	s3Client := c.S3Client(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
@@ -158,6 +250,27 @@ func (c *AWSClient) HTTPClient(context.Context) *http.Client {
 	return c.httpClient
 }
 
+// DefaultTagsConfigForResource returns the default tags configuration for the specified resource type,
+// with any overrides applied and any placeholders in tag values resolved.
+func (c *AWSClient) DefaultTagsConfigForResource(servicePackageName, typeName string) *tftags.DefaultConfig {
+	return c.DefaultTagsConfig.ForResource(servicePackageName, typeName).Resolve(tftags.DefaultTagsTemplateData{
+		AccountID:          c.AccountID,
+		Region:             c.Region,
+		ResourceType:       typeName,
+		TerraformWorkspace: c.terraformWorkspace,
+	})
+}
+
+// DefaultTagsConfigFromContext returns the default tags configuration for the resource or data source in Context,
+// falling back to the provider's default tags configuration.
+func (c *AWSClient) DefaultTagsConfigFromContext(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
+	return c.DefaultTagsConfig
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
@@ -231,8 +344,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.AwsRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -241,8 +354,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.AwsRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -285,6 +398,17 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	// Any client-side rate limit applies to all of the service's API clients.
+	if l, ok := c.rateLimiters[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(cfg.APIOptions, l.sdkv2Middleware())
+		m["aws_sdkv2_config"] = &cfg
+
+		sess := c.session.Copy()
+		l.addSDKv1Handlers(&sess.Handlers)
+		m["session"] = sess
+	}
+
 	return m
 }
 
@@ -347,6 +471,9 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	// API clients for a per-resource Region override are those of the AWSClient for that Region.
+	c = c.ForRegion(overrideRegion(ctx, c))
+
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
@@ -409,6 +536,9 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	// API clients for a per-resource Region override are those of the AWSClient for that Region.
+	c = c.ForRegion(overrideRegion(ctx, c))
+
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
@@ -6,6 +6,9 @@ package conns
 import (
 	"context"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +184,166 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientAwsRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	awsClient := &AWSClient{
+		dnsSuffix: "amazonaws.com",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+	testCases := []struct {
+		Name             string
+		Context          func(context.Context) context.Context
+		Expected         string
+		ExpectedCacheKey string
+	}{
+		{
+			Name:     "no resource context",
+			Context:  func(ctx context.Context) context.Context { return ctx },
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "no override",
+			Context: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, "ec2", "VPC", "aws_vpc")
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override is configured Region",
+			Context: func(ctx context.Context) context.Context {
+				ctx = NewResourceContext(ctx, "ec2", "VPC", "aws_vpc")
+				inContext, _ := FromContext(ctx)
+				inContext.Region = "us-west-2" //lintignore:AWSAT003
+				return ctx
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			Context: func(ctx context.Context) context.Context {
+				ctx = NewResourceContext(ctx, "ec2", "VPC", "aws_vpc")
+				inContext, _ := FromContext(ctx)
+				inContext.Region = "eu-west-1" //lintignore:AWSAT003
+				return ctx
+			},
+			Expected: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.Context(context.TODO())
+
+			if got, want := awsClient.AwsRegion(ctx), testCase.Expected; got != want {
+				t.Errorf("AwsRegion: got %s, expected %s", got, want)
+			}
+
+			if got, want := awsClient.RegionalHostname(ctx, "test"), "test."+testCase.Expected+".amazonaws.com"; got != want {
+				t.Errorf("RegionalHostname: got %s, expected %s", got, want)
+			}
+
+			if got, want := awsClient.ForRegion(awsClient.AwsRegion(ctx)).Region, testCase.Expected; got != want {
+				t.Errorf("ForRegion: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	awsClient := &AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		awsConfig: &aws_sdkv2.Config{
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		dnsSuffix: "amazonaws.com",
+	}
+
+	if got := awsClient.ForRegion(""); got != awsClient {
+		t.Errorf("empty Region: got %p, expected %p", got, awsClient)
+	}
+	if got := awsClient.ForRegion("us-west-2"); got != awsClient { //lintignore:AWSAT003
+		t.Errorf("configured Region: got %p, expected %p", got, awsClient)
+	}
+
+	regionalClient := awsClient.ForRegion("eu-west-1") //lintignore:AWSAT003
+
+	if regionalClient == awsClient {
+		t.Fatal("override Region: got configured Region's AWSClient")
+	}
+	if got, want := regionalClient.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region: got %s, expected %s", got, want)
+	}
+	if got, want := regionalClient.AwsConfig(context.TODO()).Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("AWS config Region: got %s, expected %s", got, want)
+	}
+	if got, want := regionalClient.AccountID, awsClient.AccountID; got != want {
+		t.Errorf("AccountID: got %s, expected %s", got, want)
+	}
+	if got, want := regionalClient.RegionalHostname(context.TODO(), "test"), "test.eu-west-1.amazonaws.com"; got != want { //lintignore:AWSAT003
+		t.Errorf("RegionalHostname: got %s, expected %s", got, want)
+	}
+	if got, want := awsClient.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("configured Region: got %s, expected %s", got, want)
+	}
+	if got, want := awsClient.AwsConfig(context.TODO()).Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("configured AWS config Region: got %s, expected %s", got, want)
+	}
+	if got := awsClient.ForRegion("eu-west-1"); got != regionalClient { //lintignore:AWSAT003
+		t.Errorf("cached: got %p, expected %p", got, regionalClient)
+	}
+}
+
+func TestAWSClientDefaultTagsConfigFromContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	providerConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(context.TODO(), map[string]any{"Owner": "provider"}),
+	}
+	resourceConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(context.TODO(), map[string]any{"Owner": "resource"}),
+	}
+	awsClient := &AWSClient{
+		DefaultTagsConfig: providerConfig,
+	}
+
+	if got, want := awsClient.DefaultTagsConfigFromContext(context.TODO()), providerConfig; got != want {
+		t.Errorf("no tags context: got %v, expected %v", got, want)
+	}
+
+	ctx := tftags.NewContext(context.TODO(), resourceConfig, nil, nil)
+	if got, want := awsClient.DefaultTagsConfigFromContext(ctx), resourceConfig; got != want {
+		t.Errorf("tags context: got %v, expected %v", got, want)
+	}
+}
+
+func TestAWSClientSetRegionInContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	awsClient := &AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(context.TODO(), map[string]any{"Location": "${region}"}),
+		},
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	ctx := NewResourceContext(context.TODO(), "EC2", "VPC", "aws_vpc")
+	ctx = tftags.NewContext(ctx, awsClient.DefaultTagsConfigForResource("EC2", "aws_vpc"), nil, nil)
+
+	awsClient.SetRegionInContext(ctx, "eu-west-1") //lintignore:AWSAT003
+
+	if got, want := awsClient.AwsRegion(ctx), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region: got %s, expected %s", got, want)
+	}
+	if got, want := awsClient.DefaultTagsConfigFromContext(ctx).GetTags().Map()["Location"], "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("default tags: got %s, expected %s", got, want)
+	}
+}
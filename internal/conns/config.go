@@ -6,11 +6,15 @@ package conns
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -21,7 +25,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -29,7 +35,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -45,11 +51,15 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RecordFile                     string
 	Region                         string
+	ReplayFile                     string
+	RequiredTagsConfig             *tftags.RequiredConfig
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRateLimits              map[string]ServiceRateLimit
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -114,8 +124,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first IAM Role is assumed by aws-sdk-go-base, any others are chained after the AWS config is loaded.
+	assumeRoles := tfslices.Filter(c.AssumeRole, func(v awsbase.AssumeRole) bool {
+		return v.RoleARN != ""
+	})
+	if len(assumeRoles) > 0 {
+		awsbaseConfig.AssumeRole = &assumeRoles[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -139,6 +153,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	if c.RecordFile != "" || c.ReplayFile != "" {
+		if err := c.configureRecording(ctx, client, &awsbaseConfig); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring AWS API traffic recording: %s", err)
+		}
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -166,6 +186,25 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if len(assumeRoles) > 1 {
+		tflog.Debug(ctx, "Assuming chained IAM Roles")
+		credentialsProvider, awsDiags := assumeRoleChainCredentialsProvider(ctx, cfg, awsbaseConfig, assumeRoles[1:])
+
+		for _, d := range awsDiags {
+			diags = append(diags, diag.Diagnostic{
+				Severity: baseSeverityToSDKSeverity(d.Severity()),
+				Summary:  d.Summary(),
+				Detail:   d.Detail(),
+			})
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		cfg.Credentials = credentialsProvider
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -209,12 +248,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		dnsSuffix = p.DNSSuffix()
 	}
 
+	if tracing.Enabled() {
+		tracing.AppendSDKv2Middlewares(&cfg)
+		tracing.AddSDKv1Handlers(&session.Handlers)
+	}
+
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -224,13 +269,86 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = make(map[string]*serviceRateLimiter, len(c.ServiceRateLimits))
+	for k, v := range c.ServiceRateLimits {
+		client.rateLimiters[k] = newServiceRateLimiter(v)
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.terraformWorkspace = terraformWorkspace(os.Getenv)
 
 	return client, diags
 }
 
+// assumeRoleChainCredentialsProvider returns a credentials provider for the last of the specified IAM Roles.
+// Each IAM Role is assumed using the credentials of the previous one, starting with the AWS config's credentials.
+func assumeRoleChainCredentialsProvider(ctx context.Context, awsConfig aws_sdkv2.Config, c awsbase.Config, assumeRoles []awsbase.AssumeRole) (aws_sdkv2.CredentialsProvider, basediag.Diagnostics) {
+	var diags basediag.Diagnostics
+
+	for i, assumeRole := range assumeRoles {
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.index":           i + 1,
+			"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+			"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+			"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+			"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+		})
+
+		client := sts_sdkv2.NewFromConfig(awsConfig, func(o *sts_sdkv2.Options) {
+			if c.StsRegion != "" {
+				o.Region = c.StsRegion
+			}
+			if c.StsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(c.StsEndpoint)
+			}
+		})
+
+		credentialsProvider := stscreds_sdkv2.NewAssumeRoleProvider(client, assumeRole.RoleARN, func(o *stscreds_sdkv2.AssumeRoleOptions) {
+			o.Duration = assumeRole.Duration
+			o.RoleSessionName = assumeRole.SessionName
+
+			if assumeRole.ExternalID != "" {
+				o.ExternalID = aws_sdkv2.String(assumeRole.ExternalID)
+			}
+
+			if assumeRole.Policy != "" {
+				o.Policy = aws_sdkv2.String(assumeRole.Policy)
+			}
+
+			for _, v := range assumeRole.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+					Arn: aws_sdkv2.String(v),
+				})
+			}
+
+			if assumeRole.SourceIdentity != "" {
+				o.SourceIdentity = aws_sdkv2.String(assumeRole.SourceIdentity)
+			}
+
+			for k, v := range assumeRole.Tags {
+				o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+					Key:   aws_sdkv2.String(k),
+					Value: aws_sdkv2.String(v),
+				})
+			}
+
+			o.TransitiveTagKeys = assumeRole.TransitiveTagKeys
+		})
+
+		// Retrieve credentials now so that any error is reported against the IAM Role that couldn't be assumed.
+		if _, err := credentialsProvider.Retrieve(ctx); err != nil {
+			c.AssumeRole = &assumeRole
+
+			return nil, diags.Append(c.NewCannotAssumeRoleError(err))
+		}
+
+		awsConfig.Credentials = aws_sdkv2.NewCredentialsCache(credentialsProvider)
+	}
+
+	return awsConfig.Credentials, diags
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
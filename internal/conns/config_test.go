@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"maps"
 	"net/http"
+	"strings"
 	"testing"
 
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
@@ -508,3 +509,118 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAssumeRoleChain(t *testing.T) { //nolint:paralleltest
+	const (
+		hubRoleARN      = "arn:aws:iam::111111111111:role/Hub"
+		workloadRoleARN = "arn:aws:iam::222222222222:role/Workload"
+	)
+
+	cases := map[string]struct {
+		assumeRoles          []any
+		expectedErrorRoleARN string
+	}{
+		"single role": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     hubRoleARN,
+					"session_name": "hub",
+				},
+			},
+		},
+		"chained roles": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     hubRoleARN,
+					"session_name": "hub",
+				},
+				map[string]any{
+					"external_id":  servicemocks.MockStsAssumeRoleExternalId,
+					"role_arn":     workloadRoleARN,
+					"session_name": "workload",
+				},
+			},
+		},
+		"chained role cannot be assumed": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     hubRoleARN,
+					"session_name": "hub",
+				},
+				map[string]any{
+					"role_arn":     workloadRoleARN,
+					"session_name": "unknown",
+				},
+			},
+			expectedErrorRoleARN: workloadRoleARN,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn":         hubRoleARN,
+					"RoleSessionName": "hub",
+				}),
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"ExternalId":      servicemocks.MockStsAssumeRoleExternalId,
+					"RoleArn":         workloadRoleARN,
+					"RoleSessionName": "workload",
+				}),
+			})
+			defer ts.Close()
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"assume_role":                 tc.assumeRoles,
+				"endpoints":                   []any{map[string]any{"sts": ts.URL}},
+				"region":                      "us-east-1",
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if tc.expectedErrorRoleARN != "" {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+
+				if got, want := diags[0].Summary, "Cannot assume IAM Role"; got != want {
+					t.Errorf("unexpected error summary: got %q, want %q", got, want)
+				}
+
+				if got, want := diags[0].Detail, tc.expectedErrorRoleARN; !strings.Contains(got, want) {
+					t.Errorf("expected error detail to contain %q, got %q", want, got)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+
+			credentials, err := meta.AwsConfig(ctx).Credentials.Retrieve(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := credentials.AccessKeyID, servicemocks.MockStsAssumeRoleAccessKey; got != want {
+				t.Errorf("unexpected access key: got %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+)
+
+// ServiceRateLimit is the client-side rate limit configured for a service's AWS API calls.
+type ServiceRateLimit struct {
+	// MaxConcurrency is the maximum number of concurrent in-flight requests. Zero means unlimited.
+	MaxConcurrency int
+	// RequestsPerSecond is the maximum sustained request rate. Zero means unlimited.
+	RequestsPerSecond float64
+}
+
+// serviceRateLimiter limits the rate and concurrency of a service's AWS API calls.
+// A single limiter is shared by all of a service's AWS SDK for Go v1 and v2 API clients.
+// Each request attempt, including retries, is limited.
+type serviceRateLimiter struct {
+	limiter   *rate.Limiter
+	semaphore chan struct{}
+	inflight  sync.Map // AWS SDK for Go v1 requests holding a semaphore slot.
+}
+
+func newServiceRateLimiter(v ServiceRateLimit) *serviceRateLimiter {
+	l := &serviceRateLimiter{}
+
+	if v.RequestsPerSecond > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(v.RequestsPerSecond), int(math.Max(1, math.Ceil(v.RequestsPerSecond))))
+	}
+
+	if v.MaxConcurrency > 0 {
+		l.semaphore = make(chan struct{}, v.MaxConcurrency)
+	}
+
+	return l
+}
+
+// acquire blocks until a request may be sent.
+// If acquire returns without error the caller must call release once the request completes.
+func (l *serviceRateLimiter) acquire(ctx context.Context) error {
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			l.release()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *serviceRateLimiter) release() {
+	if l.semaphore != nil {
+		<-l.semaphore
+	}
+}
+
+// addSDKv1Handlers adds request handlers that limit an AWS SDK for Go v1 session's API calls.
+// Signing is run for each request attempt immediately before the request is sent.
+func (l *serviceRateLimiter) addSDKv1Handlers(handlers *request.Handlers) {
+	handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: "tf_aws.ServiceRateLimit.Acquire",
+		Fn: func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			if err := l.acquire(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "waiting for service rate limit", err)
+				return
+			}
+
+			l.inflight.Store(r, struct{}{})
+		},
+	})
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "tf_aws.ServiceRateLimit.Release",
+		Fn: func(r *request.Request) {
+			if _, ok := l.inflight.LoadAndDelete(r); ok {
+				l.release()
+			}
+		},
+	})
+}
+
+// sdkv2Middleware returns an AWS SDK for Go v2 API option that limits a client's API calls.
+// The middleware is added at the end of the Finalize step so that it runs for each request attempt.
+func (l *serviceRateLimiter) sdkv2Middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(
+			"tf_aws.ServiceRateLimit",
+			func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				if err := l.acquire(ctx); err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+				defer l.release()
+
+				return next.HandleFinalize(ctx, in)
+			},
+		), middleware.After)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestServiceRateLimiterMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newServiceRateLimiter(ServiceRateLimit{MaxConcurrency: 2})
+
+	var inflight, maxInflight int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := l.acquire(ctx); err != nil {
+				t.Error(err)
+				return
+			}
+			defer l.release()
+
+			n := atomic.AddInt32(&inflight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInflight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInflight, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&inflight, -1)
+		}()
+	}
+
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&maxInflight), int32(2); got > want {
+		t.Errorf("maximum concurrent requests = %d, want at most %d", got, want)
+	}
+}
+
+func TestServiceRateLimiterRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newServiceRateLimiter(ServiceRateLimit{RequestsPerSecond: 20})
+
+	start := time.Now()
+	for i := 0; i < 21; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+		l.release()
+	}
+
+	// The initial burst is 20 requests, after which requests are spaced at 50ms intervals.
+	if got, want := time.Since(start), 40*time.Millisecond; got < want {
+		t.Errorf("elapsed time = %s, want at least %s", got, want)
+	}
+}
+
+func TestServiceRateLimiterCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceRateLimiter(ServiceRateLimit{MaxConcurrency: 1})
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.acquire(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+
+	l.release()
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Errorf("acquiring after release: %s", err)
+	}
+}
+
+func TestServiceRateLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newServiceRateLimiter(ServiceRateLimit{})
+
+	for i := 0; i < 100; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
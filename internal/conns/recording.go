@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+
+	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	// Placeholder credentials used to sign requests that are replayed from a cassette.
+	replayAccessKey = "replay_access_key"
+	replaySecretKey = "replay_secret_key"
+)
+
+// configureRecording sets up the HTTP client used for AWS API calls to record all AWS API traffic to,
+// or replay it from, the configured cassette file.
+func (c *Config) configureRecording(ctx context.Context, client *AWSClient, awsbaseConfig *awsbase.Config) error {
+	httpClient := client.HTTPClient(ctx)
+
+	if httpClient == nil {
+		opts, err := awsbaseConfig.HTTPTransportOptions()
+
+		if err != nil {
+			return err
+		}
+
+		transport := cleanhttp.DefaultPooledTransport()
+		opts(transport)
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	cassetteFile, mode := c.RecordFile, recorder.ModeRecordOnly
+
+	if c.ReplayFile != "" {
+		cassetteFile, mode = c.ReplayFile, recorder.ModeReplayOnly
+
+		// Replayed requests don't reach AWS, so no real credentials are needed.
+		// A request without a recorded interaction fails immediately rather than being retried.
+		awsbaseConfig.AccessKey = replayAccessKey
+		awsbaseConfig.AssumeRoleWithWebIdentity = nil
+		awsbaseConfig.EC2MetadataServiceEnableState = imds_sdkv2.ClientDisabled
+		awsbaseConfig.MaxRetries = 1
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.SecretKey = replaySecretKey
+		awsbaseConfig.Token = ""
+	}
+
+	tflog.Info(ctx, "Configuring AWS API traffic recording", map[string]any{
+		"tf_aws.vcr.cassette": cassetteFile,
+		"tf_aws.vcr.mode":     mode,
+	})
+
+	httpClient, err := vcr.NewHTTPClient(ctx, httpClient, cassetteFile, mode)
+
+	if err != nil {
+		return err
+	}
+
+	awsbaseConfig.HTTPClient = httpClient
+
+	return nil
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"github.com/YakDriver/regexache"
+)
+
+// importIDRegionRegexp matches an import ID with a Region suffix, e.g. "vpc-12345678@eu-west-1".
+var importIDRegionRegexp = regexache.MustCompile(`^(.+)@([a-z]{2}(?:-[a-z]+)+-\d)$`)
+
+// ParseImportIDRegion splits any per-resource Region override suffix from an import ID.
+func ParseImportIDRegion(id string) (string, string) {
+	if m := importIDRegionRegexp.FindStringSubmatch(id); m != nil {
+		return m[1], m[2]
+	}
+
+	return id, ""
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestParseImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		importID       string
+		expectedID     string
+		expectedRegion string
+	}{
+		{
+			importID:   "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		{
+			importID:       "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			importID:       "arn:aws:sns:us-west-2:123456789012:topic@us-gov-west-1", //lintignore:AWSAT003,AWSAT005
+			expectedID:     "arn:aws:sns:us-west-2:123456789012:topic",               //lintignore:AWSAT003,AWSAT005
+			expectedRegion: "us-gov-west-1",                                          //lintignore:AWSAT003
+		},
+		{
+			importID:   "user@example.com",
+			expectedID: "user@example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.importID, func(t *testing.T) {
+			t.Parallel()
+
+			id, region := ParseImportIDRegion(testCase.importID)
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("ID = %v, want %v", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	defaultTerraformDataDir   = ".terraform"
+	defaultTerraformWorkspace = "default"
+)
+
+// terraformWorkspace returns the name of the currently selected Terraform workspace.
+// Terraform doesn't send the workspace name to providers, so it is determined the same way Terraform does:
+// from the TF_WORKSPACE environment variable, or else from the `environment` file in the Terraform data directory.
+// Providers run in the Terraform working directory.
+func terraformWorkspace(getenv func(string) string) string {
+	if v := getenv("TF_WORKSPACE"); v != "" {
+		return v
+	}
+
+	dataDir := defaultTerraformDataDir
+	if v := getenv("TF_DATA_DIR"); v != "" {
+		dataDir = v
+	}
+
+	if b, err := os.ReadFile(filepath.Join(dataDir, "environment")); err == nil {
+		if v := strings.TrimSpace(string(b)); v != "" {
+			return v
+		}
+	}
+
+	return defaultTerraformWorkspace
+}
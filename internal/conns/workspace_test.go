@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTerraformWorkspace(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dataDir, "environment"), []byte("staging\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		env      map[string]string
+		expected string
+	}{
+		"no workspace selected": {
+			env:      map[string]string{"TF_DATA_DIR": t.TempDir()},
+			expected: "default",
+		},
+		"environment file": {
+			env:      map[string]string{"TF_DATA_DIR": dataDir},
+			expected: "staging",
+		},
+		"environment variable": {
+			env:      map[string]string{"TF_DATA_DIR": dataDir, "TF_WORKSPACE": "production"},
+			expected: "production",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(key string) string {
+				return testCase.env[key]
+			}
+
+			if got, want := terraformWorkspace(getenv), testCase.expected; got != want {
+				t.Errorf("terraformWorkspace = %q, want %q", got, want)
+			}
+		})
+	}
+}
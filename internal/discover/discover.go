@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package discover enumerates existing resources in an AWS account so that they can be imported into Terraform.
+// Resources are listed using the sweepers registered via sweep.Register, without deleting anything.
+package discover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Resource is an existing resource that can be imported.
+type Resource struct {
+	ImportID       string   // Import ID, e.g. the resource's ID
+	Names          []string // Identifiers, e.g. ID and name
+	Region         string   // AWS Region the resource is in, empty for resources of global services
+	RegionOverride bool     // Whether the resource type supports the per-resource Region override
+	Type           string   // Terraform resource type, e.g. "aws_sqs_queue"
+}
+
+// resourceType describes a resource type implemented in a service package.
+type resourceType struct {
+	importable     bool
+	regionOverride bool
+	typeName       string
+}
+
+// importIDer is implemented by Sweepables that can report the resource's import ID.
+type importIDer interface {
+	ImportID(context.Context) string
+}
+
+// namer is implemented by Sweepables that can report the identifiers, e.g. ID and name, of the resource.
+type namer interface {
+	Names(context.Context) []string
+}
+
+// tagger is implemented by Sweepables that can report the tags of the resource.
+type tagger interface {
+	Tags(context.Context) (map[string]string, error)
+}
+
+var registerOnce sync.Once
+
+// NewClient returns an AWS client configured from the specified provider configuration,
+// e.g. map[string]any{"region": "us-west-2"}.
+func NewClient(ctx context.Context, config map[string]any) (*conns.AWSClient, error) {
+	p, err := provider.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config)); diags.HasError() {
+		return nil, sdkdiag.DiagnosticsError(diags)
+	}
+
+	return p.Meta().(*conns.AWSClient), nil
+}
+
+// ResourceTypes returns the resource types implemented in the specified service packages that can be discovered,
+// i.e. that have a registered lister and can be imported.
+// All service packages are considered if none are specified.
+func ResourceTypes(ctx context.Context, client *conns.AWSClient, servicePackageNames ...string) ([]string, error) {
+	registerOnce.Do(registerSweepers)
+
+	if len(servicePackageNames) == 0 {
+		servicePackageNames = maps.Keys(client.ServicePackages)
+	}
+
+	var resourceTypes []string
+
+	for _, servicePackageName := range servicePackageNames {
+		sp, ok := client.ServicePackages[servicePackageName]
+		if !ok {
+			return nil, fmt.Errorf("unknown service package: %s", servicePackageName)
+		}
+
+		types, err := servicePackageResourceTypes(ctx, servicePackageName, sp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range types {
+			if !v.importable {
+				continue
+			}
+
+			if _, ok := sweep.Lister(v.typeName); ok {
+				resourceTypes = append(resourceTypes, v.typeName)
+			}
+		}
+	}
+
+	slices.Sort(resourceTypes)
+
+	return resourceTypes, nil
+}
+
+// Resources lists the existing resources of the specified types in each client's Region.
+// Resources of global services are listed using only the first client.
+// If tags are specified only resources with all the tags are returned.
+func Resources(ctx context.Context, clients []*conns.AWSClient, resourceTypes []string, tags map[string]string) ([]Resource, error) {
+	registerOnce.Do(registerSweepers)
+
+	if len(clients) == 0 {
+		return nil, nil
+	}
+
+	global, regionOverride, err := resourceTypeRegionality(ctx, clients[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	var errs []error
+
+	for i, client := range clients {
+		for _, resourceType := range resourceTypes {
+			_, isGlobal := global[resourceType]
+			if isGlobal && i > 0 {
+				continue
+			}
+
+			v, err := listResources(ctx, client, resourceType, tags)
+			if err != nil {
+				errs = append(errs, err)
+			}
+
+			_, supportsRegionOverride := regionOverride[resourceType]
+
+			for _, resource := range v {
+				if isGlobal {
+					resource.Region = ""
+				}
+				resource.RegionOverride = supportsRegionOverride
+				resources = append(resources, resource)
+			}
+		}
+	}
+
+	return resources, errors.Join(errs...)
+}
+
+// listResources lists the existing resources of the specified type in the client's Region.
+func listResources(ctx context.Context, client *conns.AWSClient, resourceType string, tags map[string]string) ([]Resource, error) {
+	f, ok := sweep.Lister(resourceType)
+	if !ok {
+		return nil, fmt.Errorf("no lister for resource type: %s", resourceType)
+	}
+
+	sweepables, err := f(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing %s (%s): %w", resourceType, client.Region, err)
+	}
+
+	var resources []Resource
+	var errs []error
+
+	for _, sweepable := range sweepables {
+		if len(tags) > 0 {
+			match, err := hasTags(ctx, sweepable, tags)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("reading %s (%s) tags: %w", resourceType, client.Region, err))
+				continue
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		resource := Resource{
+			Region: client.Region,
+			Type:   resourceType,
+		}
+
+		if v, ok := sweepable.(namer); ok {
+			resource.Names = v.Names(ctx)
+		}
+
+		if v, ok := sweepable.(importIDer); ok {
+			resource.ImportID = v.ImportID(ctx)
+		} else if len(resource.Names) > 0 {
+			resource.ImportID = resource.Names[0]
+		}
+
+		if resource.ImportID == "" {
+			continue
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, errors.Join(errs...)
+}
+
+// hasTags returns whether the resource has all the specified tags.
+func hasTags(ctx context.Context, sweepable sweep.Sweepable, tags map[string]string) (bool, error) {
+	v, ok := sweepable.(tagger)
+	if !ok {
+		return false, nil
+	}
+
+	resourceTags, err := v.Tags(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for key, value := range tags {
+		if v, ok := resourceTags[key]; !ok || v != value {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// resourceTypeRegionality returns the resource types implemented in global service packages
+// and the resource types that support the per-resource Region override.
+func resourceTypeRegionality(ctx context.Context, client *conns.AWSClient) (map[string]struct{}, map[string]struct{}, error) {
+	global := make(map[string]struct{})
+	regionOverride := make(map[string]struct{})
+
+	for servicePackageName, sp := range client.ServicePackages {
+		types, err := servicePackageResourceTypes(ctx, servicePackageName, sp)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, v := range types {
+			if names.IsGlobal(servicePackageName) {
+				global[v.typeName] = struct{}{}
+			}
+
+			if v.regionOverride {
+				regionOverride[v.typeName] = struct{}{}
+			}
+		}
+	}
+
+	return global, regionOverride, nil
+}
+
+// servicePackageResourceTypes returns the types of the resources implemented in the service package.
+func servicePackageResourceTypes(ctx context.Context, servicePackageName string, sp conns.ServicePackage) ([]resourceType, error) {
+	var types []resourceType
+
+	for _, v := range sp.SDKResources(ctx) {
+		r := v.Factory()
+		types = append(types, resourceType{
+			importable:     r.Importer != nil,
+			regionOverride: provider.SupportsRegionOverride(servicePackageName, r.SchemaMap()),
+			typeName:       v.TypeName,
+		})
+	}
+
+	for _, v := range sp.FrameworkResources(ctx) {
+		r, err := v.Factory(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var metadataResponse fwresource.MetadataResponse
+		r.Metadata(ctx, fwresource.MetadataRequest{}, &metadataResponse)
+		var schemaResponse fwresource.SchemaResponse
+		r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+		_, importable := r.(fwresource.ResourceWithImportState)
+		types = append(types, resourceType{
+			importable:     importable,
+			regionOverride: fwprovider.SupportsRegionOverride(servicePackageName, schemaResponse.Schema.Attributes, schemaResponse.Schema.Blocks),
+			typeName:       metadataResponse.TypeName,
+		})
+	}
+
+	return types, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discover_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/discover"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newMockSQSServer returns a mock SQS endpoint that lists the specified queues.
+func newMockSQSServer(queueURLs ...string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") != "AmazonSQS.ListQueues" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+
+		var urls string
+		for i, v := range queueURLs {
+			if i > 0 {
+				urls += ","
+			}
+			urls += fmt.Sprintf("%q", v)
+		}
+		fmt.Fprintf(w, `{"QueueUrls":[%s]}`, urls)
+	}))
+}
+
+func newMockClient(ctx context.Context, t *testing.T, region, sqsEndpoint string) *conns.AWSClient {
+	t.Helper()
+
+	client, err := discover.NewClient(ctx, map[string]any{
+		"access_key":                  servicemocks.MockStaticAccessKey,
+		"endpoints":                   []any{map[string]any{"sqs": sqsEndpoint}},
+		"region":                      region,
+		"secret_key":                  servicemocks.MockStaticSecretKey,
+		"skip_credentials_validation": true,
+		"skip_requesting_account_id":  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := newMockSQSServer()
+	defer ts.Close()
+
+	client := newMockClient(ctx, t, "us-west-2", ts.URL)
+
+	got, err := discover.ResourceTypes(ctx, client, names.SQS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(got, []string{"aws_sqs_queue"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := discover.ResourceTypes(ctx, client, "nosuchservice"); err == nil {
+		t.Error("expected error for unknown service package, got none")
+	}
+}
+
+func TestResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const (
+		queueURL1 = "https://sqs.us-west-2.amazonaws.com/123456789012/orders"
+		queueURL2 = "https://sqs.us-east-1.amazonaws.com/123456789012/invoices"
+	)
+
+	ts1 := newMockSQSServer(queueURL1)
+	defer ts1.Close()
+	ts2 := newMockSQSServer(queueURL2)
+	defer ts2.Close()
+
+	clients := []*conns.AWSClient{
+		newMockClient(ctx, t, "us-west-2", ts1.URL),
+		newMockClient(ctx, t, "us-east-1", ts2.URL),
+	}
+
+	got, err := discover.Resources(ctx, clients, []string{"aws_sqs_queue"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []discover.Resource{
+		{
+			ImportID:       queueURL1,
+			Names:          []string{queueURL1},
+			Region:         "us-west-2",
+			RegionOverride: true,
+			Type:           "aws_sqs_queue",
+		},
+		{
+			ImportID:       queueURL2,
+			Names:          []string{queueURL2},
+			Region:         "us-east-1",
+			RegionOverride: true,
+			Type:           "aws_sqs_queue",
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
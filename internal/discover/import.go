@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discover
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// WriteImportBlocks writes a Terraform import block for each resource.
+// Resources in a Region other than the default Region are imported using an `<id>@<region>` import ID,
+// see the per-resource Region override. Such resources of types that don't support the override are skipped
+// and a warning is returned for each.
+// The generated configuration can be completed using `terraform plan -generate-config-out`.
+func WriteImportBlocks(w io.Writer, resources []Resource, defaultRegion string) ([]string, error) {
+	var warnings []string
+	addresses := make(map[string]struct{})
+
+	for _, resource := range resources {
+		id := resource.ImportID
+		if resource.Region != "" && resource.Region != defaultRegion {
+			if !resource.RegionOverride {
+				warnings = append(warnings, fmt.Sprintf("skipping %s %q in %s: resource type does not support the per-resource Region override", resource.Type, resource.ImportID, resource.Region))
+				continue
+			}
+
+			id += "@" + resource.Region
+		}
+
+		// Resource addresses must be unique.
+		label := resourceLabel(resource)
+		address := resource.Type + "." + label
+		for n := 2; ; n++ {
+			if _, ok := addresses[address]; !ok {
+				break
+			}
+			address = fmt.Sprintf("%s.%s_%d", resource.Type, label, n)
+		}
+		addresses[address] = struct{}{}
+
+		if _, err := fmt.Fprintf(w, "import {\n  to = %s\n  id = %s\n}\n\n", address, hclString(id)); err != nil {
+			return warnings, err
+		}
+	}
+
+	return warnings, nil
+}
+
+// resourceLabel returns a valid Terraform resource name for the resource, derived from its most descriptive identifier.
+func resourceLabel(resource Resource) string {
+	name := resource.ImportID
+	if n := len(resource.Names); n > 0 {
+		name = resource.Names[n-1]
+	}
+
+	// Use the last component of ARNs, URLs and paths.
+	if i := strings.LastIndexAny(strings.TrimRight(name, "/"), "/:"); i >= 0 {
+		name = strings.TrimRight(name, "/")[i+1:]
+	}
+
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteRune('_')
+		}
+	}
+
+	label := strings.Trim(sb.String(), "_")
+	if label == "" {
+		return "this"
+	}
+
+	// Labels must start with a letter or underscore.
+	if r := rune(label[0]); !unicode.IsLetter(r) {
+		label = "_" + label
+	}
+
+	return label
+}
+
+// hclString returns the value as a quoted HCL string literal, escaping template sequences.
+func hclString(v string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(strconv.Quote(v))
+}
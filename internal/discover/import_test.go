@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discover
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteImportBlocks(t *testing.T) {
+	t.Parallel()
+
+	resources := []Resource{
+		{
+			ImportID:       "https://sqs.us-west-2.amazonaws.com/123456789012/orders",
+			Names:          []string{"https://sqs.us-west-2.amazonaws.com/123456789012/orders"},
+			Region:         "us-west-2",
+			RegionOverride: true,
+			Type:           "aws_sqs_queue",
+		},
+		{
+			ImportID:       "https://sqs.us-east-1.amazonaws.com/123456789012/orders",
+			Names:          []string{"https://sqs.us-east-1.amazonaws.com/123456789012/orders"},
+			Region:         "us-east-1",
+			RegionOverride: true,
+			Type:           "aws_sqs_queue",
+		},
+		{
+			ImportID: "app",
+			Region:   "us-east-1",
+			Type:     "aws_servicecatalogappregistry_application",
+		},
+		{
+			ImportID: "Deploy Role",
+			Names:    []string{"Deploy Role"},
+			Type:     "aws_iam_role",
+		},
+		{
+			ImportID: "42",
+			Region:   "us-west-2",
+			Type:     "aws_xray_group",
+		},
+		{
+			ImportID: "${var}",
+			Region:   "us-west-2",
+			Type:     "aws_xray_group",
+		},
+	}
+
+	var sb strings.Builder
+	warnings, err := WriteImportBlocks(&sb, resources, "us-west-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedWarnings := []string{
+		`skipping aws_servicecatalogappregistry_application "app" in us-east-1: resource type does not support the per-resource Region override`,
+	}
+
+	if diff := cmp.Diff(warnings, expectedWarnings); diff != "" {
+		t.Errorf("unexpected warnings diff (+wanted, -got): %s", diff)
+	}
+
+	expected := `import {
+  to = aws_sqs_queue.orders
+  id = "https://sqs.us-west-2.amazonaws.com/123456789012/orders"
+}
+
+import {
+  to = aws_sqs_queue.orders_2
+  id = "https://sqs.us-east-1.amazonaws.com/123456789012/orders@us-east-1"
+}
+
+import {
+  to = aws_iam_role.deploy_role
+  id = "Deploy Role"
+}
+
+import {
+  to = aws_xray_group._42
+  id = "42"
+}
+
+import {
+  to = aws_xray_group.var
+  id = "$${var}"
+}
+
+`
+
+	if diff := cmp.Diff(sb.String(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestResourceLabel(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource Resource
+		expected string
+	}{
+		"ID": {
+			resource: Resource{ImportID: "sg-0123456789abcdef0"},
+			expected: "sg-0123456789abcdef0",
+		},
+		"name": {
+			resource: Resource{ImportID: "AIDAEXAMPLE", Names: []string{"AIDAEXAMPLE", "ci-user"}},
+			expected: "ci-user",
+		},
+		"ARN": {
+			resource: Resource{ImportID: "arn:aws:iam::123456789012:role/service-role/Deployer"},
+			expected: "deployer",
+		},
+		"trailing slash": {
+			resource: Resource{ImportID: "https://example.com/path/"},
+			expected: "path",
+		},
+		"leading digit": {
+			resource: Resource{ImportID: "2024.backup"},
+			expected: "_2024_backup",
+		},
+		"no valid characters": {
+			resource: Resource{ImportID: "***"},
+			expected: "this",
+		},
+		"non-ASCII": {
+			resource: Resource{ImportID: "café"},
+			expected: "caf",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := resourceLabel(testCase.resource), testCase.expected; got != want {
+				t.Errorf("resourceLabel() = %q, want %q", got, want)
+			}
+		})
+	}
+}
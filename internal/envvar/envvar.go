@@ -84,6 +84,21 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control which resources sweepers delete
+const (
+	// If true, sweepers list the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of prefixes, one of which a resource's ID or name must start with to be swept
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Path to a file to which a JSON Lines report of swept, skipped and failed resources is appended
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// Comma-separated list of key=value tags, all of which a resource must have to be swept
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
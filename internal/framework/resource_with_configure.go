@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -37,6 +38,13 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 
 	defaultTagsConfig := r.Meta().DefaultTagsConfig
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
+	var requiredTagsConfig *tftags.RequiredConfig
+
+	// Prefer any default tags scoped to the resource type.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+		requiredTagsConfig = inContext.RequiredConfig
+	}
 
 	var planTags types.Map
 
@@ -51,6 +59,11 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 			resourceTags := tftags.New(ctx, planTags)
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+			if err := requiredTagsConfig.Validate(allTags); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Invalid tags", fmt.Sprintf("tags_all does not comply with the provider's required_tags: %s", err))
+				return
+			}
+
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// parseCIDRBlock validates that the specified CIDR block is a network address
+// and returns its prefix.
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.ParsePrefix(cidr)
+}
+
+// parseNetworkCIDRBlock validates that the specified CIDR block is an IPv4 or IPv6
+// network address and returns its prefix.
+func parseNetworkCIDRBlock(cidr string) (netip.Prefix, error) {
+	validate := verify.ValidateIPv4CIDRBlock
+	if strings.Contains(cidr, ":") {
+		validate = verify.ValidateIPv6CIDRBlock
+	}
+
+	if err := validate(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.ParsePrefix(cidr)
+}
+
+// parseCIDRBlockOrIPAddress parses the specified value as either a CIDR block or
+// a single IP address. A single IP address is returned as a host prefix.
+func parseCIDRBlockOrIPAddress(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return parseCIDRBlock(s)
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid IP address or CIDR block", s)
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// cidrSubnet describes a named subnet of a specific prefix length.
+type cidrSubnet struct {
+	Name         string `tfsdk:"name"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+}
+
+// subnetsBySize carves the base CIDR block into consecutive subnets in the order specified.
+// Each subnet is aligned on its own size boundary, so that any space skipped for alignment
+// is left unallocated and subnets keep their addresses when new subnets are appended.
+func subnetsBySize(base netip.Prefix, subnets []cidrSubnet) (map[string]string, error) {
+	maxBits := base.Addr().BitLen()
+	baseBits := base.Bits()
+	baseSize := new(big.Int).Lsh(big.NewInt(1), uint(maxBits-baseBits))
+	baseAddr := new(big.Int).SetBytes(base.Addr().AsSlice())
+
+	result := make(map[string]string, len(subnets))
+	offset := new(big.Int)
+
+	for _, subnet := range subnets {
+		if subnet.Name == "" {
+			return nil, fmt.Errorf("subnet name must not be empty")
+		}
+		if _, ok := result[subnet.Name]; ok {
+			return nil, fmt.Errorf("duplicate subnet name %q", subnet.Name)
+		}
+
+		prefixLength := int(subnet.PrefixLength)
+		if prefixLength < baseBits || prefixLength > maxBits {
+			return nil, fmt.Errorf("subnet %q prefix length (%d) must be between %d and %d", subnet.Name, prefixLength, baseBits, maxBits)
+		}
+
+		size := new(big.Int).Lsh(big.NewInt(1), uint(maxBits-prefixLength))
+
+		// Round the offset up to the next multiple of the subnet size.
+		if rem := new(big.Int).Mod(offset, size); rem.Sign() != 0 {
+			offset.Add(offset, new(big.Int).Sub(size, rem))
+		}
+
+		if end := new(big.Int).Add(offset, size); end.Cmp(baseSize) > 0 {
+			return nil, fmt.Errorf("insufficient space in %s for subnet %q (/%d)", base, subnet.Name, prefixLength)
+		}
+
+		addr, ok := netip.AddrFromSlice(new(big.Int).Add(baseAddr, offset).FillBytes(make([]byte, maxBits/8)))
+		if !ok {
+			return nil, fmt.Errorf("computing address for subnet %q", subnet.Name)
+		}
+
+		result[subnet.Name] = netip.PrefixFrom(addr, prefixLength).String()
+		offset.Add(offset, size)
+	}
+
+	return result, nil
+}
+
+// cidrBlockContains returns whether the containing CIDR block wholly contains the
+// specified CIDR block or IP address.
+func cidrBlockContains(containing, contained netip.Prefix) bool {
+	if containing.Addr().BitLen() != contained.Addr().BitLen() {
+		return false
+	}
+
+	return contained.Bits() >= containing.Bits() && containing.Contains(contained.Addr())
+}
+
+// cidrBlocksOverlap returns whether any CIDR block in the first list overlaps any
+// CIDR block in the second list.
+func cidrBlocksOverlap(cidrs1, cidrs2 []netip.Prefix) bool {
+	for _, cidr1 := range cidrs1 {
+		for _, cidr2 := range cidrs2 {
+			if cidr1.Overlaps(cidr2) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var cidrSubnetAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+var _ function.Function = cidrSubnetsBySizeFunction{}
+
+func NewCIDRSubnetsBySizeFunction() function.Function {
+	return &cidrSubnetsBySizeFunction{}
+}
+
+type cidrSubnetsBySizeFunction struct{}
+
+func (f cidrSubnetsBySizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_by_size"
+}
+
+func (f cidrSubnetsBySizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_by_size Function",
+		MarkdownDescription: "Carves an IPv4 or IPv6 CIDR block into consecutive, named subnets of the specified " +
+			"prefix lengths. Each subnet is aligned on its own size boundary.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base_cidr",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to allocate subnets from",
+			},
+			function.ListParameter{
+				Name:                "subnets",
+				MarkdownDescription: "Ordered list of subnets to allocate, each with a unique `name` and a `prefix_length`",
+				ElementType: types.ObjectType{
+					AttrTypes: cidrSubnetAttrTypes,
+				},
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsBySizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var baseCIDR string
+	var subnets []cidrSubnet
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &baseCIDR, &subnets))
+	if resp.Error != nil {
+		return
+	}
+
+	base, err := parseNetworkCIDRBlock(baseCIDR)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := subnetsBySize(base, subnets)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsBySizeFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsBySizeFunctionConfig("10.0.0.0/16", `
+    { name = "public-a", prefix_length = 24 },
+    { name = "private-a", prefix_length = 20 },
+    { name = "public-b", prefix_length = 24 },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.16.0/20"),
+					resource.TestCheckOutput("public_b", "10.0.32.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsBySizeFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsBySizeFunctionConfig("2001:db8::/56", `
+    { name = "public-a", prefix_length = 64 },
+    { name = "private-a", prefix_length = 60 },
+    { name = "public-b", prefix_length = 64 },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "2001:db8::/64"),
+					resource.TestCheckOutput("private_a", "2001:db8:0:10::/60"),
+					resource.TestCheckOutput("public_b", "2001:db8:0:20::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsBySizeFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsBySizeFunctionConfig("10.0.0.0/24", `
+    { name = "public-a", prefix_length = 25 },
+    { name = "private-a", prefix_length = 24 },
+    { name = "public-b", prefix_length = 25 },
+`),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsBySizeFunction_invalidBaseCIDR(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsBySizeFunctionConfig("10.0.0.1/16", `
+    { name = "public-a", prefix_length = 24 },
+`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IPv4[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsBySizeFunctionConfig(baseCIDR, subnets string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_by_size(%[1]q, [%[2]s])
+}
+
+output "public_a" {
+  value = local.subnets["public-a"]
+}
+
+output "private_a" {
+  value = try(local.subnets["private-a"], null)
+}
+
+output "public_b" {
+  value = try(local.subnets["public-b"], null)
+}
+`, baseCIDR, subnets)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSubnetsBySize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		base      string
+		subnets   []cidrSubnet
+		expected  map[string]string
+		expectErr bool
+	}{
+		"empty": {
+			base:     "10.0.0.0/16",
+			expected: map[string]string{},
+		},
+		"ipv4 aligned": {
+			base: "10.0.0.0/16",
+			subnets: []cidrSubnet{
+				{Name: "a", PrefixLength: 24},
+				{Name: "b", PrefixLength: 20},
+				{Name: "c", PrefixLength: 28},
+			},
+			expected: map[string]string{
+				"a": "10.0.0.0/24",
+				"b": "10.0.16.0/20",
+				"c": "10.0.32.0/28",
+			},
+		},
+		"ipv4 whole block": {
+			base: "192.168.1.0/24",
+			subnets: []cidrSubnet{
+				{Name: "a", PrefixLength: 24},
+			},
+			expected: map[string]string{
+				"a": "192.168.1.0/24",
+			},
+		},
+		"ipv4 exhausted": {
+			base: "192.168.1.0/24",
+			subnets: []cidrSubnet{
+				{Name: "a", PrefixLength: 25},
+				{Name: "b", PrefixLength: 25},
+				{Name: "c", PrefixLength: 32},
+			},
+			expectErr: true,
+		},
+		"ipv6": {
+			base: "2001:db8::/56",
+			subnets: []cidrSubnet{
+				{Name: "a", PrefixLength: 64},
+				{Name: "b", PrefixLength: 64},
+				{Name: "c", PrefixLength: 60},
+			},
+			expected: map[string]string{
+				"a": "2001:db8::/64",
+				"b": "2001:db8:0:1::/64",
+				"c": "2001:db8:0:10::/60",
+			},
+		},
+		"prefix length too short": {
+			base: "10.0.0.0/16",
+			subnets: []cidrSubnet{
+				{Name: "a", PrefixLength: 8},
+			},
+			expectErr: true,
+		},
+		"prefix length too long": {
+			base: "10.0.0.0/16",
+			subnets: []cidrSubnet{
+				{Name: "a", PrefixLength: 33},
+			},
+			expectErr: true,
+		},
+		"duplicate name": {
+			base: "10.0.0.0/16",
+			subnets: []cidrSubnet{
+				{Name: "a", PrefixLength: 24},
+				{Name: "a", PrefixLength: 24},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := subnetsBySize(netip.MustParsePrefix(testCase.base), testCase.subnets)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Fatalf("subnetsBySize() err %t, want %t (%v)", got, want, err)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCIDRBlockContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		containing string
+		contained  string
+		expected   bool
+	}{
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.0.255.0/24", true},
+		{"10.0.0.0/16", "10.0.0.10", true},
+		{"10.0.0.0/16", "10.1.0.0/24", false},
+		{"10.0.0.0/16", "10.0.0.0/8", false},
+		{"2001:db8::/32", "2001:db8:1::/48", true},
+		{"2001:db8::/32", "10.0.0.1", false},
+		{"0.0.0.0/0", "2001:db8::1", false},
+	}
+
+	for _, testCase := range testCases {
+		containing, err := parseCIDRBlock(testCase.containing)
+		if err != nil {
+			t.Fatalf("parsing %q: %s", testCase.containing, err)
+		}
+
+		contained, err := parseCIDRBlockOrIPAddress(testCase.contained)
+		if err != nil {
+			t.Fatalf("parsing %q: %s", testCase.contained, err)
+		}
+
+		if got, want := cidrBlockContains(containing, contained), testCase.expected; got != want {
+			t.Errorf("cidrBlockContains(%q, %q) = %t, want %t", testCase.containing, testCase.contained, got, want)
+		}
+	}
+}
+
+func TestParseCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	for _, cidr := range []string{"10.0.0.1/16", "invalid", "2001:db8::1/32"} {
+		if _, err := parseCIDRBlock(cidr); err == nil {
+			t.Errorf("parseCIDRBlock(%q) expected error", cidr)
+		}
+	}
+}
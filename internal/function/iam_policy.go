@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// defaultPolicyVersion matches the default version of the aws_iam_policy_document data source.
+	defaultPolicyVersion = "2012-10-17"
+)
+
+// parsePolicyDocument parses and normalizes an IAM policy document.
+func parsePolicyDocument(policy string) (*tfiam.IAMPolicyDoc, error) {
+	doc := &tfiam.IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, err
+	}
+
+	doc.Normalize()
+
+	return doc, nil
+}
+
+// mergePolicyDocuments merges source and override policy documents using the same rules as the
+// aws_iam_policy_document data source's source_policy_documents and override_policy_documents:
+// Sids must be unique across source documents, while override documents replace statements with
+// the same Sid.
+func mergePolicyDocuments(sourceDocuments, overrideDocuments []string) (*tfiam.IAMPolicyDoc, error) {
+	mergedDoc := &tfiam.IAMPolicyDoc{
+		Version: defaultPolicyVersion,
+	}
+
+	sidMap := make(map[string]struct{})
+	for i, sourceJSON := range sourceDocuments {
+		sourceDoc, err := parsePolicyDocument(sourceJSON)
+		if err != nil {
+			return nil, fmt.Errorf("merging source document %d: %w", i, err)
+		}
+
+		for stmtIndex, stmt := range sourceDoc.Statements {
+			if stmt.Sid != "" {
+				if _, ok := sidMap[stmt.Sid]; ok {
+					return nil, fmt.Errorf("merging source document %d: duplicate Sid (%s) in source documents (statement %d). Remove the Sid or ensure Sids are unique.", i, stmt.Sid, stmtIndex)
+				}
+				sidMap[stmt.Sid] = struct{}{}
+			}
+		}
+
+		mergedDoc.Merge(sourceDoc)
+	}
+
+	for i, overrideJSON := range overrideDocuments {
+		overrideDoc, err := parsePolicyDocument(overrideJSON)
+		if err != nil {
+			return nil, fmt.Errorf("merging override document %d: %w", i, err)
+		}
+
+		mergedDoc.Merge(overrideDoc)
+	}
+
+	return mergedDoc, nil
+}
+
+// policyStatementsDiff returns the JSON representation of statements that are present in the
+// new policy document but not the old one (added), and vice versa (removed).
+// Statements are compared for semantic equivalence.
+func policyStatementsDiff(oldDoc, newDoc *tfiam.IAMPolicyDoc) ([]string, []string, error) {
+	oldStatements, err := marshalPolicyStatements(oldDoc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newStatements, err := marshalPolicyStatements(newDoc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matched := make([]bool, len(newStatements))
+	removed := make([]string, 0)
+	for _, oldStatement := range oldStatements {
+		found := false
+		for i, newStatement := range newStatements {
+			if matched[i] {
+				continue
+			}
+			if policyStatementsEquivalent(oldStatement, newStatement) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, oldStatement)
+		}
+	}
+
+	added := make([]string, 0)
+	for i, newStatement := range newStatements {
+		if !matched[i] {
+			added = append(added, newStatement)
+		}
+	}
+
+	return added, removed, nil
+}
+
+func marshalPolicyStatements(doc *tfiam.IAMPolicyDoc) ([]string, error) {
+	statements := make([]string, 0, len(doc.Statements))
+
+	for _, stmt := range doc.Statements {
+		b, err := json.Marshal(stmt)
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, string(b))
+	}
+
+	return statements, nil
+}
+
+// policyStatementsEquivalent compares two JSON policy statements for semantic equivalence
+// by wrapping each in an otherwise identical policy document.
+func policyStatementsEquivalent(stmt1, stmt2 string) bool {
+	const format = `{"Version":"%s","Statement":[%s]}`
+
+	return verify.PolicyStringsEquivalent(fmt.Sprintf(format, defaultPolicyVersion, stmt1), fmt.Sprintf(format, defaultPolicyVersion, stmt2))
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single policy document. Statements in source " +
+			"documents must have unique Sids, while statements in override documents replace any existing " +
+			"statement with the same Sid.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "source_documents",
+				MarkdownDescription: "IAM policy documents to merge, in order",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "override_documents",
+				MarkdownDescription: "IAM policy documents to merge, in order, replacing statements with the same Sid",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sourceDocuments, overrideDocuments []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &sourceDocuments, &overrideDocuments))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := mergePolicyDocuments(sourceDocuments, overrideDocuments)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := json.Marshal(doc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
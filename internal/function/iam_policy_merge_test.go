@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(`
+    { Sid = "Read", Effect = "Allow", Action = ["s3:GetObject"], Resource = "*" },
+`, `
+    { Sid = "Read", Effect = "Allow", Action = ["s3:GetObject", "s3:ListBucket"], Resource = "*" },
+    { Effect = "Deny", Action = "s3:DeleteObject", Resource = "*" },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:ListBucket","s3:GetObject"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(`
+    { Sid = "Read", Effect = "Allow", Action = "s3:GetObject", Resource = "*" },
+    { Sid = "Read", Effect = "Allow", Action = "s3:ListBucket", Resource = "*" },
+`, ""),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(sourceStatements, overrideStatements string) string {
+	return fmt.Sprintf(`
+locals {
+  source = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [%[1]s]
+  })
+  override = jsonencode({
+    Statement = [%[2]s]
+  })
+}
+
+output "test" {
+  value = provider::aws::iam_policy_merge([local.source], [local.override])
+}
+`, sourceStatements, overrideStatements)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var iamPolicyStatementsDiffResultAttrTypes = map[string]attr.Type{
+	"added":   types.ListType{ElemType: types.StringType},
+	"removed": types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = iamPolicyStatementsDiffFunction{}
+
+func NewIAMPolicyStatementsDiffFunction() function.Function {
+	return &iamPolicyStatementsDiffFunction{}
+}
+
+type iamPolicyStatementsDiffFunction struct{}
+
+func (f iamPolicyStatementsDiffFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_statements_diff"
+}
+
+func (f iamPolicyStatementsDiffFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_statements_diff Function",
+		MarkdownDescription: "Compares the statements of two IAM policy documents, returning the statements " +
+			"added to and removed from the first document",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "old_policy",
+				MarkdownDescription: "IAM policy document to compare from",
+			},
+			function.StringParameter{
+				Name:                "new_policy",
+				MarkdownDescription: "IAM policy document to compare to",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamPolicyStatementsDiffResultAttrTypes,
+		},
+	}
+}
+
+func (f iamPolicyStatementsDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var oldPolicy, newPolicy string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &oldPolicy, &newPolicy))
+	if resp.Error != nil {
+		return
+	}
+
+	oldDoc, err := parsePolicyDocument(oldPolicy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	newDoc, err := parsePolicyDocument(newPolicy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	added, removed, err := policyStatementsDiff(oldDoc, newDoc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	addedValue, d := types.ListValueFrom(ctx, types.StringType, added)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	removedValue, d := types.ListValueFrom(ctx, types.StringType, removed)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"added":   addedValue,
+		"removed": removedValue,
+	}
+
+	result, d := types.ObjectValue(iamPolicyStatementsDiffResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
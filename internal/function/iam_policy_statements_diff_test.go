@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyStatementsDiffFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyStatementsDiffFunctionConfig(
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket","s3:GetObject"],"Resource":"*"},{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`,
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":["*"]},{"Effect":"Allow","Action":"sns:Publish","Resource":"*"}]}`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("added", `[{"Effect":"Allow","Action":"sns:Publish","Resource":"*"}]`),
+					resource.TestCheckOutput("removed", `[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyStatementsDiffFunction_equivalent(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyStatementsDiffFunctionConfig(
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("added", `[]`),
+					resource.TestCheckOutput("removed", `[]`),
+				),
+			},
+		},
+	})
+}
+
+func testIAMPolicyStatementsDiffFunctionConfig(oldPolicy, newPolicy string) string {
+	return fmt.Sprintf(`
+locals {
+  diff = provider::aws::iam_policy_statements_diff(%[1]q, %[2]q)
+}
+
+output "added" {
+  value = "[${join(",", local.diff.added)}]"
+}
+
+output "removed" {
+  value = "[${join(",", local.diff.removed)}]"
+}
+`, oldPolicy, newPolicy)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMergePolicyDocuments(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sourceDocuments   []string
+		overrideDocuments []string
+		expected          string
+		expectErr         bool
+	}{
+		"empty": {
+			expected: `{"Version":"2012-10-17"}`,
+		},
+		"sources": {
+			sourceDocuments: []string{
+				`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+		},
+		"duplicate source Sid": {
+			sourceDocuments: []string{
+				`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			},
+			expectErr: true,
+		},
+		"overrides": {
+			sourceDocuments: []string{
+				`{"Id":"source","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			overrideDocuments: []string{
+				`{"Id":"override1","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Id":"override1","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		"invalid JSON": {
+			overrideDocuments: []string{"{"},
+			expectErr:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := mergePolicyDocuments(testCase.sourceDocuments, testCase.overrideDocuments)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Fatalf("mergePolicyDocuments() err %t, want %t (%v)", got, want, err)
+			}
+
+			if err == nil {
+				got, err := json.Marshal(doc)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyStatementsDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldPolicy       string
+		newPolicy       string
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		"equivalent": {
+			oldPolicy:       `{"Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			newPolicy:       `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["*"]}]}`,
+			expectedAdded:   []string{},
+			expectedRemoved: []string{},
+		},
+		"changed Sid statement": {
+			oldPolicy:       `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			newPolicy:       `{"Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			expectedAdded:   []string{`{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}`},
+			expectedRemoved: []string{`{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`},
+		},
+		"duplicate statements": {
+			oldPolicy:       `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			newPolicy:       `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			expectedAdded:   []string{`{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}`},
+			expectedRemoved: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			oldDoc, err := parsePolicyDocument(testCase.oldPolicy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			newDoc, err := parsePolicyDocument(testCase.newPolicy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			added, removed, err := policyStatementsDiff(oldDoc, newDoc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(added, testCase.expectedAdded); diff != "" {
+				t.Errorf("unexpected added diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(removed, testCase.expectedRemoved); diff != "" {
+				t.Errorf("unexpected removed diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
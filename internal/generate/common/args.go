@@ -8,7 +8,8 @@ import (
 )
 
 // Args represents an argument list of the form:
-// postional0, keywordA=valueA, positional1, keywordB=valueB
+// postional0, keywordA=valueA, positional1, keywordB=valueB, keywordC=[valueC0, valueC1]
+// List values are stored verbatim, including the brackets, and can be split using ParseList.
 type Args struct {
 	Positional []string
 	Keyword    map[string]string
@@ -22,14 +23,22 @@ func ParseArgs(s string) Args {
 
 	for s != "" {
 		key, s, _ = strings.Cut(s, ",")
+		// A list value may itself contain commas.
+		if _, value, _ := strings.Cut(key, "="); strings.HasPrefix(strings.TrimSpace(value), "[") {
+			for !strings.Contains(key, "]") && s != "" {
+				var more string
+				more, s, _ = strings.Cut(s, ",")
+				key += "," + more
+			}
+		}
 		key = strings.TrimSpace(key)
 		if key == "" {
 			continue
 		}
 		key, value, _ := strings.Cut(key, "=")
 		// Unquote.
-		key = strings.Trim(key, `"`)
-		value = strings.Trim(value, `"`)
+		key = strings.Trim(strings.TrimSpace(key), `"`)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
 		if value == "" {
 			args.Positional = append(args.Positional, key)
 		} else {
@@ -39,3 +48,22 @@ func ParseArgs(s string) Args {
 
 	return args
 }
+
+// ParseList parses a list value of the form:
+// [value0, value1]
+func ParseList(s string) []string {
+	var list []string
+
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "[")
+	s = strings.TrimSuffix(s, "]")
+
+	for _, v := range strings.Split(s, ",") {
+		// Unquote.
+		if v := strings.Trim(strings.TrimSpace(v), `"`); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
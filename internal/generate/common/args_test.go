@@ -117,3 +117,52 @@ func TestArgsPostionalAndKeywords(t *testing.T) {
 		t.Errorf("Keyword[type] = %v, want %v", got, want)
 	}
 }
+
+func TestArgsListKeyword(t *testing.T) {
+	t.Parallel()
+
+	input := `"aws_instance", dependencies=["aws_eip", aws_network_interface], vv=42`
+	args := ParseArgs(input)
+
+	if got, want := len(args.Positional), 1; got != want {
+		t.Errorf("length of Positional = %v, want %v", got, want)
+	}
+	if got, want := args.Positional[0], "aws_instance"; got != want {
+		t.Errorf("Positional[0] = %v, want %v", got, want)
+	}
+	if got, want := len(args.Keyword), 2; got != want {
+		t.Errorf("length of Keyword = %v, want %v", got, want)
+	}
+	if got, want := args.Keyword["dependencies"], `["aws_eip", aws_network_interface]`; got != want {
+		t.Errorf("Keyword[dependencies] = %v, want %v", got, want)
+	}
+	if got, want := args.Keyword["vv"], "42"; got != want {
+		t.Errorf("Keyword[vv] = %v, want %v", got, want)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string][]string{
+		``:                     nil,
+		`[]`:                   nil,
+		`["aws_eip"]`:          {"aws_eip"},
+		`[aws_eip, "aws_vpc"]`: {"aws_eip", "aws_vpc"},
+	}
+
+	for input, want := range testCases {
+		got := ParseList(input)
+
+		if len(got) != len(want) {
+			t.Errorf("ParseList(%q) = %v, want %v", input, got, want)
+			continue
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("ParseList(%q) = %v, want %v", input, got, want)
+				break
+			}
+		}
+	}
+}
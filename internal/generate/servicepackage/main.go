@@ -262,6 +262,8 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
+			case "Sweeper":
+				// See internal/generate/sweepers.
 			case "Tags":
 				// Handled above.
 			case "Testing":
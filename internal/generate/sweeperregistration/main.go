@@ -9,6 +9,7 @@ package main
 import (
 	_ "embed"
 	"errors"
+	"flag"
 	"fmt"
 	"io/fs"
 	"os"
@@ -19,7 +20,9 @@ import (
 )
 
 type ServiceDatum struct {
-	ProviderPackage string
+	ProviderPackage   string
+	GeneratedSweepers bool // Whether the service package has sweepers generated from @Sweeper annotations
+	Sweepers          bool // Whether the service package has hand-written sweepers
 }
 
 type TemplateData struct {
@@ -28,9 +31,14 @@ type TemplateData struct {
 }
 
 func main() {
-	const (
-		filename = `register_gen_test.go`
-	)
+	filename := `register_gen_test.go`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
 	g := common.NewGenerator()
 
 	packageName := os.Getenv("GOPACKAGE")
@@ -58,13 +66,15 @@ func main() {
 			continue
 		}
 
-		if _, err := os.Stat(fmt.Sprintf("../service/%s/sweep.go", p)); err != nil || errors.Is(err, fs.ErrNotExist) {
-			g.Infof("No sweepers for %q", p)
-			continue
+		s := ServiceDatum{
+			ProviderPackage:   p,
+			GeneratedSweepers: fileExists(fmt.Sprintf("../service/%s/sweep_gen.go", p)),
+			Sweepers:          fileExists(fmt.Sprintf("../service/%s/sweep.go", p)),
 		}
 
-		s := ServiceDatum{
-			ProviderPackage: p,
+		if !s.GeneratedSweepers && !s.Sweepers {
+			g.Infof("No sweepers for %q", p)
+			continue
 		}
 
 		td.Services = append(td.Services, s)
@@ -85,5 +95,11 @@ func main() {
 	}
 }
 
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+
+	return err == nil
+}
+
 //go:embed file.tmpl
 var tmpl string
@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"golang.org/x/tools/go/packages"
+)
+
+func main() {
+	filename := `sweep_gen.go`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	g := common.NewGenerator()
+
+	data, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	for _, l := range data {
+		// See internal/generate/namesconsts/main.go.
+		p := l.ProviderPackage()
+
+		if p != servicePackage {
+			continue
+		}
+
+		if !l.ClientSDKV2() {
+			g.Fatalf("@Sweeper annotations require an AWS SDK for Go v2 client: %s", p)
+		}
+
+		// Look for sweeper annotations.
+		// These annotations are implemented as comments on resource factory functions.
+		v := &visitor{
+			g: g,
+		}
+
+		v.processDir(".")
+
+		if err := errors.Join(v.errs...); err != nil {
+			g.Fatalf("%s", err.Error())
+		}
+
+		if len(v.sweepers) == 0 {
+			g.Fatalf("no @Sweeper annotations found: %s", p)
+		}
+
+		s := ServiceDatum{
+			GoV2Package:       l.GoV2Package(),
+			ProviderPackage:   p,
+			ProviderNameUpper: l.ProviderNameUpper(),
+			Sweepers:          v.sweepers,
+		}
+
+		if err := s.resolve(); err != nil {
+			g.Fatalf("%s", err.Error())
+		}
+
+		sort.SliceStable(s.Sweepers, func(i, j int) bool {
+			return s.Sweepers[i].TypeName < s.Sweepers[j].TypeName
+		})
+
+		d := g.NewGoFileDestination(filename)
+
+		if err := d.WriteTemplate("sweepers", tmpl, s); err != nil {
+			g.Fatalf("error generating %s sweepers: %s", p, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		break
+	}
+}
+
+type SweeperDatum struct {
+	Dependencies []string
+	FactoryName  string
+	Framework    bool   // Whether the resource is implemented using the Terraform Plugin Framework
+	FunctionName string // Name of the generated sweeper function, e.g. "sweepQueues"
+	IDField      string // Name of the identifier field in the list operation's output
+	IDPointer    bool   // Whether the identifier field is a *string
+	IDValue      bool   // Whether the listed items are themselves the identifiers
+	ItemsField   string // Name of the list operation's output field holding the listed items
+	List         string // Name of the list operation, e.g. "ListQueues"
+	Paginated    bool   // Whether the AWS SDK for Go v2 defines a paginator for the list operation
+	TypeName     string
+}
+
+type ServiceDatum struct {
+	GoV2Package       string // AWS SDK for Go v2 package name
+	ProviderPackage   string
+	ProviderNameUpper string
+	Sweepers          []SweeperDatum
+}
+
+func (s ServiceDatum) HasFramework() bool {
+	for _, v := range s.Sweepers {
+		if v.Framework {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s ServiceDatum) UsesAWS() bool {
+	for _, v := range s.Sweepers {
+		if v.IDPointer {
+			return true
+		}
+	}
+
+	return false
+}
+
+//go:embed file.tmpl
+var tmpl string
+
+// resolve uses the AWS SDK for Go v2 package's type information to determine how each sweeper lists resources.
+func (s ServiceDatum) resolve() error {
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", s.GoV2Package)
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes}, sourcePackage)
+	if err != nil {
+		return fmt.Errorf("loading (%s): %w", sourcePackage, err)
+	}
+	if len(pkgs) != 1 || pkgs[0].Types == nil {
+		return fmt.Errorf("loading (%s): %d packages found", sourcePackage, len(pkgs))
+	}
+	scope := pkgs[0].Types.Scope()
+
+	var errs []error
+
+	for i, v := range s.Sweepers {
+		if err := v.resolve(scope); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", v.TypeName, err))
+			continue
+		}
+
+		s.Sweepers[i] = v
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *SweeperDatum) resolve(scope *types.Scope) error {
+	if scope.Lookup(d.List+"Input") == nil {
+		return fmt.Errorf("list operation input type not found: %sInput", d.List)
+	}
+
+	output := scope.Lookup(d.List + "Output")
+	if output == nil {
+		return fmt.Errorf("list operation output type not found: %sOutput", d.List)
+	}
+
+	d.Paginated = scope.Lookup("New"+d.List+"Paginator") != nil
+
+	outputStruct, ok := output.Type().Underlying().(*types.Struct)
+	if !ok {
+		return fmt.Errorf("unexpected list operation output type: %s", output.Type())
+	}
+
+	var candidates []SweeperDatum
+
+	for i := 0; i < outputStruct.NumFields(); i++ {
+		field := outputStruct.Field(i)
+
+		if d.ItemsField != "" && field.Name() != d.ItemsField {
+			continue
+		}
+
+		slice, ok := field.Type().Underlying().(*types.Slice)
+		if !ok {
+			continue
+		}
+
+		candidate := *d
+		candidate.ItemsField = field.Name()
+
+		// The listed items are identifiers, e.g. SQS ListQueues' QueueUrls.
+		if field.Name() == d.IDField {
+			if !isString(slice.Elem()) {
+				continue
+			}
+
+			candidate.IDValue = true
+			candidates = append(candidates, candidate)
+			continue
+		}
+
+		// The listed items are structures with an identifier field.
+		elem := slice.Elem()
+		if ptr, ok := elem.(*types.Pointer); ok {
+			elem = ptr.Elem()
+		}
+
+		elemStruct, ok := elem.Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		for j := 0; j < elemStruct.NumFields(); j++ {
+			idField := elemStruct.Field(j)
+
+			if idField.Name() != d.IDField {
+				continue
+			}
+
+			switch typ := idField.Type(); {
+			case isString(typ):
+			case isStringPointer(typ):
+				candidate.IDPointer = true
+			default:
+				return fmt.Errorf("unsupported identifier field type (%s.%s): %s", field.Name(), idField.Name(), typ)
+			}
+
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return fmt.Errorf("no list of items with identifier field %q found in %sOutput", d.IDField, d.List)
+	case 1:
+		*d = candidates[0]
+		return nil
+	default:
+		return fmt.Errorf("multiple lists of items with identifier field %q found in %sOutput, specify items", d.IDField, d.List)
+	}
+}
+
+func isString(typ types.Type) bool {
+	basic, ok := typ.(*types.Basic)
+
+	return ok && basic.Kind() == types.String
+}
+
+func isStringPointer(typ types.Type) bool {
+	ptr, ok := typ.(*types.Pointer)
+
+	return ok && isString(ptr.Elem())
+}
+
+// Annotation processing.
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	sweepers []SweeperDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for a Sweeper annotation and the accompanying resource annotation.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	var (
+		d          SweeperDatum
+		hasSweeper bool
+		typeName   string
+		framework  bool
+		resource   bool
+	)
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		m := annotation.FindStringSubmatch(line)
+		if len(m) == 0 {
+			continue
+		}
+
+		args := common.ParseArgs(m[3])
+
+		switch m[1] {
+		case "FrameworkResource":
+			framework, resource = true, true
+		case "SDKResource":
+			resource = true
+			if len(args.Positional) > 0 {
+				typeName = args.Positional[0]
+			}
+		case "Sweeper":
+			if hasSweeper {
+				v.errs = append(v.errs, fmt.Errorf("multiple Sweeper annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+			hasSweeper = true
+
+			// The type name of Terraform Plugin Framework resources isn't part of their annotation.
+			if len(args.Positional) > 0 {
+				d.TypeName = args.Positional[0]
+			}
+			d.List = args.Keyword["list"]
+			d.IDField = args.Keyword["idField"]
+			d.ItemsField = args.Keyword["items"]
+			d.Dependencies = common.ParseList(args.Keyword["dependencies"])
+		}
+	}
+
+	if !hasSweeper {
+		v.functionName = ""
+
+		return
+	}
+
+	switch qualifiedName := fmt.Sprintf("%s.%s", v.packageName, v.functionName); {
+	case !resource:
+		v.errs = append(v.errs, fmt.Errorf("Sweeper annotation on a function that is not a resource factory: %s", qualifiedName))
+	case d.List == "":
+		v.errs = append(v.errs, fmt.Errorf("Sweeper annotation has no list operation: %s", qualifiedName))
+	case d.IDField == "":
+		v.errs = append(v.errs, fmt.Errorf("Sweeper annotation has no identifier field: %s", qualifiedName))
+	case framework && d.TypeName == "":
+		v.errs = append(v.errs, fmt.Errorf("Sweeper annotation on a Framework resource has no type name: %s", qualifiedName))
+	case !framework && d.TypeName != "" && d.TypeName != typeName:
+		v.errs = append(v.errs, fmt.Errorf("Sweeper annotation type name (%s) does not match SDK resource type name (%s): %s", d.TypeName, typeName, qualifiedName))
+	default:
+		if d.TypeName == "" {
+			d.TypeName = typeName
+		}
+		d.FactoryName = v.functionName
+		d.Framework = framework
+		d.FunctionName = sweepFunctionName(v.functionName)
+
+		v.sweepers = append(v.sweepers, d)
+	}
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+// sweepFunctionName returns the name of the generated sweeper function for a resource factory function,
+// e.g. "resourceQueue" -> "sweepQueues", "newResourceApplication" -> "sweepApplications".
+func sweepFunctionName(factoryName string) string {
+	name := factoryName
+	for _, prefix := range []string{"newResource", "resource", "Resource", "new"} {
+		if v := strings.TrimPrefix(name, prefix); v != name && v != "" {
+			name = v
+			break
+		}
+	}
+	if v := strings.TrimSuffix(name, "Resource"); v != "" {
+		name = v
+	}
+
+	return "sweep" + strings.ToUpper(name[:1]) + name[1:] + "s"
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -198,15 +200,21 @@ type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	inner            datasource.DataSourceWithConfigure
-	interceptors     dataSourceInterceptors
-	meta             *conns.AWSClient
+	// innerSchema is the inner data source's schema, without the per-resource `region` attribute.
+	innerSchema  datasourceschema.Schema
+	interceptors dataSourceInterceptors
+	meta         *conns.AWSClient
+	// regionOverride is set if the data source supports the per-resource `region` override.
+	regionOverride bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regionOverride bool, innerSchema datasourceschema.Schema) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
+		innerSchema:      innerSchema,
 		interceptors:     interceptors,
+		regionOverride:   regionOverride,
 	}
 }
 
@@ -218,10 +226,17 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverride {
+		addRegionOverrideDataSourceAttribute(&response.Schema)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.readInRegion(ctx, request, response)
+		}
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -253,15 +268,21 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
-	meta             *conns.AWSClient
+	// innerSchema is the inner resource's schema, without the per-resource `region` attribute.
+	innerSchema  resourceschema.Schema
+	interceptors resourceInterceptors
+	meta         *conns.AWSClient
+	// regionOverride is set if the resource supports the per-resource `region` override.
+	regionOverride bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regionOverride bool, innerSchema resourceschema.Schema) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
+		innerSchema:      innerSchema,
 		interceptors:     interceptors,
+		regionOverride:   regionOverride,
 	}
 }
 
@@ -273,10 +294,17 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverride {
+		addRegionOverrideResourceAttribute(&response.Schema)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.createInRegion(ctx, request, response)
+		}
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -287,6 +315,9 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.readInRegion(ctx, request, response)
+		}
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -297,6 +328,9 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.updateInRegion(ctx, request, response)
+		}
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -307,6 +341,9 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.deleteInRegion(ctx, request, response)
+		}
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -326,6 +363,10 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionOverride {
+			w.importStateInRegion(ctx, v, request, response)
+			return
+		}
 		v.ImportState(ctx, request, response)
 
 		return
@@ -340,6 +381,10 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionOverride {
+			w.modifyPlanInRegion(ctx, v, request, response)
+			return
+		}
 		v.ModifyPlan(ctx, request, response)
 	}
 }
@@ -356,6 +401,10 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionOverride {
+			w.validateConfigInRegion(ctx, v, request, response)
+			return
+		}
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -363,6 +412,9 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionOverride {
+			return w.upgradeStateInRegion(v.UpgradeState(ctx))
+		}
 
 		return v.UpgradeState(ctx)
 	}
@@ -373,6 +425,9 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionOverride {
+			return w.moveStateInRegion(v.MoveState(ctx))
+		}
 
 		return v.MoveState(ctx)
 	}
@@ -21,6 +21,7 @@ import (
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -96,10 +97,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"record_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file to which all AWS API traffic is recorded. Credentials and secret values are redacted from the recording.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"replay_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file, previously written via `record_file`, from which all AWS API traffic is replayed. No requests are sent to AWS and no credentials are required.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -167,9 +176,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume, in order, prior to making API calls. Each role is assumed using the credentials of the previous one.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -259,12 +266,45 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"precedence": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether resource tags or default tags take precedence when both have the same key.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"override": schema.ListNestedBlock{
+							Description: "Configuration blocks with settings to change the default tags of specific resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Default tag keys to not apply to matching resources.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_instance`, that the override applies to.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service keys, as used in the `endpoints` configuration block, that the override applies to.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags to add to matching resources' default tags",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -288,6 +328,46 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"required_tags": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to require tags on all taggable resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_values": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions, one of which the tag's value must fully match.",
+						},
+						names.AttrKey: schema.StringAttribute{
+							Required:    true,
+							Description: "The key of the required tag.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_instance`, that must have the tag. Defaults to all taggable resource types.",
+						},
+					},
+				},
+			},
+			"service_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate of AWS API calls made to individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrency": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent requests to the service's API.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests per second to the service's API.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service key, as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -333,22 +413,28 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfigForResource(servicePackageName, typeName), meta.IgnoreTagsConfig, nil)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
 			interceptors := dataSourceInterceptors{}
 
+			// The per-resource `region` override must be the first interceptor so that all other interceptors see it.
+			regionOverride := SupportsRegionOverride(servicePackageName, schemaResponse.Schema.Attributes, schemaResponse.Schema.Blocks)
+			if regionOverride {
+				interceptors = append(interceptors, regionDataSourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -362,8 +448,26 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
+			if tracing.Enabled() {
+				interceptors = append(interceptors, tracingDataSourceInterceptor{
+					tracingInterceptor: tracingInterceptor{typeName: typeName},
+				})
+			}
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				if !regionOverride {
+					return newWrappedDataSource(bootstrapContext, inner, interceptors, regionOverride, schemaResponse.Schema)
+				}
+
+				// The inner instance is configured with the AWSClient for any per-resource Region override,
+				// so each wrapped instance has its own.
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					return newFactoryErrorDataSource(typeName, schemaResponse.Schema, err)
+				}
+
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, regionOverride, schemaResponse.Schema)
 			})
 		}
 	}
@@ -404,22 +508,33 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					var requiredTagsConfig *tftags.RequiredConfig
+					// Required tags are only enforced for resources that have opted in to transparent tagging.
+					if v.Tags != nil {
+						requiredTagsConfig = meta.RequiredTagsConfig.ForResource(typeName)
+					}
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfigForResource(servicePackageName, typeName), meta.IgnoreTagsConfig, requiredTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
 			interceptors := resourceInterceptors{}
 
+			// The per-resource `region` override must be the first interceptor so that all other interceptors see it.
+			regionOverride := SupportsRegionOverride(servicePackageName, schemaResponse.Schema.Attributes, schemaResponse.Schema.Blocks)
+			if regionOverride {
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -442,8 +557,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if tracing.Enabled() {
+				interceptors = append(interceptors, tracingResourceInterceptor{
+					tracingInterceptor: tracingInterceptor{typeName: typeName},
+				})
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				if !regionOverride {
+					return newWrappedResource(bootstrapContext, inner, interceptors, regionOverride, schemaResponse.Schema)
+				}
+
+				// The inner instance is configured with the AWSClient for any per-resource Region override,
+				// so each wrapped instance has its own.
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					return newFactoryErrorResource(typeName, schemaResponse.Schema, err)
+				}
+
+				return newWrappedResource(bootstrapContext, inner, interceptors, regionOverride, schemaResponse.Schema)
 			})
 		}
 	}
@@ -466,6 +599,12 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSubnetsBySizeFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewIAMPolicyStatementsDiffFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
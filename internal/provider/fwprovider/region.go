@@ -0,0 +1,594 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The per-resource `region` override is implemented in the wrappers around resources and data sources.
+// The `region` attribute is hidden from the inner resource or data source, whose requests and responses
+// conform to its own schema, so that its models need not include the attribute.
+// The inner resource or data source is configured with the AWSClient for the effective Region,
+// so that values derived from the AWSClient's Region, e.g. ARNs, are consistent with the Region that API calls are sent to.
+
+// SupportsRegionOverride returns whether the resource or data source can be given a per-resource `region` override.
+// Resources that already define a top-level `region` attribute or block keep their own semantics.
+func SupportsRegionOverride[A, B any](servicePackageName string, attributes map[string]A, blocks map[string]B) bool {
+	if names.IsGlobal(servicePackageName) {
+		return false
+	}
+
+	if _, ok := attributes[names.AttrRegion]; ok {
+		return false
+	}
+	if _, ok := blocks[names.AttrRegion]; ok {
+		return false
+	}
+
+	return true
+}
+
+func regionValidator() validator.String {
+	return stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`), "must be a valid AWS Region Code")
+}
+
+// addRegionOverrideResourceAttribute adds the `region` attribute to the resource's schema.
+func addRegionOverrideResourceAttribute(s *resourceschema.Schema) {
+	// Schema attribute maps may be shared, so are copied rather than modified.
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]resourceschema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			regionValidator(),
+		},
+	}
+}
+
+// addRegionOverrideDataSourceAttribute adds the `region` attribute to the data source's schema.
+func addRegionOverrideDataSourceAttribute(s *datasourceschema.Schema) {
+	// Schema attribute maps may be shared, so are copied rather than modified.
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]datasourceschema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = datasourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			regionValidator(),
+		},
+	}
+}
+
+// attributeGetter is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}
+
+// setRegionInContext sets any per-resource `region` override in Context.
+func setRegionInContext(ctx context.Context, getter attributeGetter, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil {
+		return diags
+	}
+
+	var region fwtypes.String
+	diags.Append(getter.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	meta.SetRegionInContext(ctx, region.ValueString())
+
+	return diags
+}
+
+// regionDataSourceInterceptor sets any per-resource `region` override in Context.
+// It must be the first interceptor in the chain so that all other interceptors see the override.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(setRegionInContext(ctx, request.Config, meta)...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor sets any per-resource `region` override in Context.
+// It must be the first interceptor in the chain so that all other interceptors see the override.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(setRegionInContext(ctx, request.Plan, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(setRegionInContext(ctx, request.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(setRegionInContext(ctx, request.Plan, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(setRegionInContext(ctx, request.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+// withoutRegion returns the object value, conforming to the specified type, without its `region` attribute.
+func withoutRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+	delete(attrs, names.AttrRegion)
+
+	if err := tftypes.ValidateValue(typ, attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(typ, attrs), nil
+}
+
+// withRegion returns the object value, conforming to the specified type, with the specified `region` attribute value.
+func withRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+	attrs[names.AttrRegion] = region
+
+	if err := tftypes.ValidateValue(typ, attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(typ, attrs), nil
+}
+
+// regionValue returns the object value's `region` attribute value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	if v.IsNull() || !v.IsKnown() {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	if v, ok := attrs[names.AttrRegion]; ok {
+		return v
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// effectiveRegionValue returns the Region that API calls made with the specified Context are sent to.
+func effectiveRegionValue(ctx context.Context, meta *conns.AWSClient) tftypes.Value {
+	if meta == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, meta.AwsRegion(ctx))
+}
+
+// removeRegion replaces each state, plan or config with its value, conforming to the inner resource's schema, without the `region` attribute.
+func (w *wrappedResource) removeRegion(ctx context.Context, states ...*tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typ := w.innerSchema.Type().TerraformType(ctx)
+	for _, s := range states {
+		raw, err := withoutRegion(s.Raw, typ)
+		if err != nil {
+			diags.AddError("removing region attribute", err.Error())
+			return diags
+		}
+		*s = tfsdk.State{Raw: raw, Schema: w.innerSchema}
+	}
+
+	return diags
+}
+
+// addRegion sets the state, plan or config to the inner resource's value with the specified `region` attribute value.
+func (w *wrappedResource) addRegion(ctx context.Context, s *tfsdk.State, inner tfsdk.State, region tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := withRegion(inner.Raw, s.Schema.Type().TerraformType(ctx), region)
+	if err != nil {
+		diags.AddError("adding region attribute", err.Error())
+		return diags
+	}
+	s.Raw = raw
+
+	return diags
+}
+
+// configureInner configures the inner resource with the AWSClient for the Region that API calls made with the specified Context are sent to.
+func (w *wrappedResource) configureInner(ctx context.Context) diag.Diagnostics {
+	response := resource.ConfigureResponse{}
+	if w.meta != nil {
+		w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: w.meta.ForRegion(w.meta.AwsRegion(ctx))}, &response)
+	}
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) createInRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+	innerRequest, innerResponse := request, *response
+	if diags := w.configureInner(ctx); diags.HasError() {
+		return diags
+	}
+	if diags := w.removeRegion(ctx, (*tfsdk.State)(&innerRequest.Config), (*tfsdk.State)(&innerRequest.Plan), &innerResponse.State); diags.HasError() {
+		return diags
+	}
+
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics, response.Private = innerResponse.Diagnostics, innerResponse.Private
+	response.Diagnostics.Append(w.addRegion(ctx, &response.State, innerResponse.State, effectiveRegionValue(ctx, w.meta))...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) readInRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+	innerRequest, innerResponse := request, *response
+	if diags := w.configureInner(ctx); diags.HasError() {
+		return diags
+	}
+	if diags := w.removeRegion(ctx, &innerRequest.State, &innerResponse.State); diags.HasError() {
+		return diags
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics, response.Private, response.Deferred = innerResponse.Diagnostics, innerResponse.Private, innerResponse.Deferred
+	response.Diagnostics.Append(w.addRegion(ctx, &response.State, innerResponse.State, effectiveRegionValue(ctx, w.meta))...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) updateInRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+	innerRequest, innerResponse := request, *response
+	if diags := w.configureInner(ctx); diags.HasError() {
+		return diags
+	}
+	if diags := w.removeRegion(ctx, (*tfsdk.State)(&innerRequest.Config), (*tfsdk.State)(&innerRequest.Plan), &innerRequest.State, &innerResponse.State); diags.HasError() {
+		return diags
+	}
+
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics, response.Private = innerResponse.Diagnostics, innerResponse.Private
+	response.Diagnostics.Append(w.addRegion(ctx, &response.State, innerResponse.State, effectiveRegionValue(ctx, w.meta))...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) deleteInRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+	innerRequest, innerResponse := request, *response
+	if diags := w.configureInner(ctx); diags.HasError() {
+		return diags
+	}
+	if diags := w.removeRegion(ctx, &innerRequest.State, &innerResponse.State); diags.HasError() {
+		return diags
+	}
+
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics, response.Private = innerResponse.Diagnostics, innerResponse.Private
+	response.Diagnostics.Append(w.addRegion(ctx, &response.State, innerResponse.State, regionValue(request.State.Raw))...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) importStateInRegion(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// An import ID may have a Region suffix, e.g. "vpc-12345678@eu-west-1".
+	if id, region := conns.ParseImportIDRegion(request.ID); region != "" {
+		request.ID = id
+		if w.meta != nil {
+			w.meta.SetRegionInContext(ctx, region)
+		}
+	}
+
+	innerResponse := *response
+	if diags := w.configureInner(ctx); diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+	if diags := w.removeRegion(ctx, &innerResponse.State); diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	inner.ImportState(ctx, request, &innerResponse)
+
+	response.Diagnostics, response.Private, response.Deferred = innerResponse.Diagnostics, innerResponse.Private, innerResponse.Deferred
+	response.Diagnostics.Append(w.addRegion(ctx, &response.State, innerResponse.State, effectiveRegionValue(ctx, w.meta))...)
+}
+
+func (w *wrappedResource) modifyPlanInRegion(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// The plan is null if the resource is being destroyed.
+	getter := attributeGetter(request.Plan)
+	if request.Plan.Raw.IsNull() {
+		getter = request.State
+	}
+	if diags := setRegionInContext(ctx, getter, w.meta); diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	innerRequest, innerResponse := request, *response
+	if diags := w.configureInner(ctx); diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+	if diags := w.removeRegion(ctx, (*tfsdk.State)(&innerRequest.Config), (*tfsdk.State)(&innerRequest.Plan), &innerRequest.State, (*tfsdk.State)(&innerResponse.Plan)); diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics, response.Private, response.Deferred, response.RequiresReplace = innerResponse.Diagnostics, innerResponse.Private, innerResponse.Deferred, innerResponse.RequiresReplace
+	response.Diagnostics.Append(w.addRegion(ctx, (*tfsdk.State)(&response.Plan), tfsdk.State(innerResponse.Plan), regionValue(response.Plan.Raw))...)
+}
+
+func (w *wrappedResource) validateConfigInRegion(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	if diags := w.removeRegion(ctx, (*tfsdk.State)(&request.Config)); diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	inner.ValidateConfig(ctx, request, response)
+}
+
+// upgradeStateInRegion returns the inner resource's state upgraders, wrapped so that their prior and upgraded states include the `region` attribute.
+func (w *wrappedResource) upgradeStateInRegion(upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+
+	for version, upgrader := range upgraders {
+		upgrader := upgrader
+		var priorSchema *resourceschema.Schema
+		if upgrader.PriorSchema != nil {
+			v := *upgrader.PriorSchema
+			addRegionOverrideResourceAttribute(&v)
+			priorSchema = &v
+		}
+
+		wrapped[version] = resource.StateUpgrader{
+			PriorSchema: priorSchema,
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				innerRequest, innerResponse := request, *response
+				region := tftypes.NewValue(tftypes.String, nil)
+				if request.State != nil {
+					region = regionValue(request.State.Raw)
+					raw, err := withoutRegion(request.State.Raw, upgrader.PriorSchema.Type().TerraformType(ctx))
+					if err != nil {
+						response.Diagnostics.AddError("removing region attribute", err.Error())
+						return
+					}
+					innerRequest.State = &tfsdk.State{Raw: raw, Schema: *upgrader.PriorSchema}
+				}
+				if diags := w.removeRegion(ctx, &innerResponse.State); diags.HasError() {
+					response.Diagnostics.Append(diags...)
+					return
+				}
+
+				upgrader.StateUpgrader(ctx, innerRequest, &innerResponse)
+
+				response.Diagnostics, response.DynamicValue = innerResponse.Diagnostics, innerResponse.DynamicValue
+				response.Diagnostics.Append(w.addRegion(ctx, &response.State, innerResponse.State, region)...)
+			},
+		}
+	}
+
+	return wrapped
+}
+
+// moveStateInRegion returns the inner resource's state movers, wrapped so that their target states include the `region` attribute.
+func (w *wrappedResource) moveStateInRegion(movers []resource.StateMover) []resource.StateMover {
+	wrapped := make([]resource.StateMover, 0, len(movers))
+
+	for _, mover := range movers {
+		mover := mover
+
+		wrapped = append(wrapped, resource.StateMover{
+			SourceSchema: mover.SourceSchema,
+			StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+				innerResponse := *response
+				if diags := w.removeRegion(ctx, &innerResponse.TargetState); diags.HasError() {
+					response.Diagnostics.Append(diags...)
+					return
+				}
+
+				mover.StateMover(ctx, request, &innerResponse)
+
+				response.Diagnostics, response.TargetPrivate = innerResponse.Diagnostics, innerResponse.TargetPrivate
+				response.Diagnostics.Append(w.addRegion(ctx, &response.TargetState, innerResponse.TargetState, tftypes.NewValue(tftypes.String, nil))...)
+			},
+		})
+	}
+
+	return wrapped
+}
+
+// configureInner configures the inner data source with the AWSClient for the Region that API calls made with the specified Context are sent to.
+func (w *wrappedDataSource) configureInner(ctx context.Context) diag.Diagnostics {
+	response := datasource.ConfigureResponse{}
+	if w.meta != nil {
+		w.inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: w.meta.ForRegion(w.meta.AwsRegion(ctx))}, &response)
+	}
+
+	return response.Diagnostics
+}
+
+func (w *wrappedDataSource) readInRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+	if diags := w.configureInner(ctx); diags.HasError() {
+		return diags
+	}
+
+	// Remove the `region` attribute from the config and state seen by the inner data source.
+	typ := w.innerSchema.Type().TerraformType(ctx)
+	config, err := withoutRegion(request.Config.Raw, typ)
+	if err != nil {
+		response.Diagnostics.AddError("removing region attribute", err.Error())
+		return response.Diagnostics
+	}
+	state, err := withoutRegion(response.State.Raw, typ)
+	if err != nil {
+		response.Diagnostics.AddError("removing region attribute", err.Error())
+		return response.Diagnostics
+	}
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config = tfsdk.Config{Raw: config, Schema: w.innerSchema}
+	innerResponse.State = tfsdk.State{Raw: state, Schema: w.innerSchema}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics, response.Deferred = innerResponse.Diagnostics, innerResponse.Deferred
+	state, err = withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), effectiveRegionValue(ctx, w.meta))
+	if err != nil {
+		response.Diagnostics.AddError("adding region attribute", err.Error())
+		return response.Diagnostics
+	}
+	response.State.Raw = state
+
+	return response.Diagnostics
+}
+
+// factoryErrorDataSource is the data source returned when a data source supporting the per-resource `region` override
+// can't be instantiated. Its inner instance can't be shared, so any use reports the error.
+type factoryErrorDataSource struct {
+	err      error
+	schema   datasourceschema.Schema
+	typeName string
+}
+
+func newFactoryErrorDataSource(typeName string, innerSchema datasourceschema.Schema, err error) datasource.DataSourceWithConfigure {
+	return &factoryErrorDataSource{
+		err:      err,
+		schema:   innerSchema,
+		typeName: typeName,
+	}
+}
+
+func (d *factoryErrorDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = d.typeName
+}
+
+func (d *factoryErrorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = d.schema
+	addRegionOverrideDataSourceAttribute(&response.Schema)
+}
+
+func (d *factoryErrorDataSource) Configure(_ context.Context, _ datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	response.Diagnostics.AddError("creating data source "+d.typeName, d.err.Error())
+}
+
+func (d *factoryErrorDataSource) Read(_ context.Context, _ datasource.ReadRequest, response *datasource.ReadResponse) {
+	response.Diagnostics.AddError("creating data source "+d.typeName, d.err.Error())
+}
+
+// factoryErrorResource is the resource returned when a resource supporting the per-resource `region` override
+// can't be instantiated. Its inner instance can't be shared, so any use reports the error.
+type factoryErrorResource struct {
+	err      error
+	schema   resourceschema.Schema
+	typeName string
+}
+
+func newFactoryErrorResource(typeName string, innerSchema resourceschema.Schema, err error) resource.ResourceWithConfigure {
+	return &factoryErrorResource{
+		err:      err,
+		schema:   innerSchema,
+		typeName: typeName,
+	}
+}
+
+func (r *factoryErrorResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = r.typeName
+}
+
+func (r *factoryErrorResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = r.schema
+	addRegionOverrideResourceAttribute(&response.Schema)
+}
+
+func (r *factoryErrorResource) Configure(_ context.Context, _ resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	response.Diagnostics.AddError("creating resource "+r.typeName, r.err.Error())
+}
+
+func (r *factoryErrorResource) Create(_ context.Context, _ resource.CreateRequest, response *resource.CreateResponse) {
+	response.Diagnostics.AddError("creating resource "+r.typeName, r.err.Error())
+}
+
+func (r *factoryErrorResource) Read(_ context.Context, _ resource.ReadRequest, response *resource.ReadResponse) {
+	response.Diagnostics.AddError("creating resource "+r.typeName, r.err.Error())
+}
+
+func (r *factoryErrorResource) Update(_ context.Context, _ resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.Diagnostics.AddError("creating resource "+r.typeName, r.err.Error())
+}
+
+func (r *factoryErrorResource) Delete(_ context.Context, _ resource.DeleteRequest, response *resource.DeleteResponse) {
+	response.Diagnostics.AddError("creating resource "+r.typeName, r.err.Error())
+}
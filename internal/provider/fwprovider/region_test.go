@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testRegionResource is a resource whose model does not include the per-resource `region` attribute.
+// Its `arn` attribute is derived from the configured AWSClient's Region.
+type testRegionResource struct {
+	meta *conns.AWSClient
+}
+
+type testRegionResourceModel struct {
+	ARN  fwtypes.String `tfsdk:"arn"`
+	ID   fwtypes.String `tfsdk:"id"`
+	Name fwtypes.String `tfsdk:"name"`
+}
+
+func (r *testRegionResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test_region"
+}
+
+func (r *testRegionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *testRegionResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+}
+
+func (r *testRegionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data testRegionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwtypes.StringValue("arn:" + r.meta.Partition + ":test:" + r.meta.Region + ":" + r.meta.AccountID + ":" + data.Name.ValueString())
+	data.ID = data.Name
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *testRegionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data testRegionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *testRegionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data testRegionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *testRegionResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *testRegionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func newTestRegionWrappedResource(ctx context.Context, t *testing.T) (resource.ResourceWithConfigure, schema.Schema) {
+	t.Helper()
+
+	inner := &testRegionResource{}
+	var schemaResponse resource.SchemaResponse
+	inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	if !SupportsRegionOverride(names.SQS, schemaResponse.Schema.Attributes, schemaResponse.Schema.Blocks) {
+		t.Fatal("SupportsRegionOverride = false, want true")
+	}
+
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		return conns.NewResourceContext(ctx, names.SQS, "Test", "aws_test_region")
+	}
+	w := newWrappedResource(bootstrapContext, inner, resourceInterceptors{regionResourceInterceptor{}}, true, schemaResponse.Schema)
+
+	w.Configure(ctx, resource.ConfigureRequest{
+		ProviderData: &conns.AWSClient{
+			AccountID: "123456789012",
+			Partition: "aws",
+			Region:    "us-west-2", //lintignore:AWSAT003
+		},
+	}, &resource.ConfigureResponse{})
+
+	var response resource.SchemaResponse
+	w.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return w, response.Schema
+}
+
+func TestWrappedResourceRegionOverrideCreate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		region         tftypes.Value
+		expectedARN    string
+		expectedRegion string
+	}{
+		{
+			name:           "no override",
+			region:         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectedARN:    "arn:aws:test:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			expectedRegion: "us-west-2",                                //lintignore:AWSAT003
+		},
+		{
+			name:           "override",
+			region:         tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			expectedARN:    "arn:aws:test:eu-west-1:123456789012:test",    //lintignore:AWSAT003,AWSAT005
+			expectedRegion: "eu-west-1",                                   //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			w, s := newTestRegionWrappedResource(ctx, t)
+
+			if _, ok := s.Attributes[names.AttrRegion]; !ok {
+				t.Fatal("no region attribute in schema")
+			}
+
+			typ := s.Type().TerraformType(ctx)
+			config := tftypes.NewValue(typ, map[string]tftypes.Value{
+				names.AttrARN:    tftypes.NewValue(tftypes.String, nil),
+				names.AttrID:     tftypes.NewValue(tftypes.String, nil),
+				names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, nil),
+			})
+			plan := tftypes.NewValue(typ, map[string]tftypes.Value{
+				names.AttrARN:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				names.AttrID:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+				names.AttrRegion: testCase.region,
+			})
+			request := resource.CreateRequest{
+				Config: tfsdk.Config{Raw: config, Schema: s},
+				Plan:   tfsdk.Plan{Raw: plan, Schema: s},
+			}
+			response := resource.CreateResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(typ, nil), Schema: s},
+			}
+
+			w.Create(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			var arn, region fwtypes.String
+			response.State.GetAttribute(ctx, path.Root(names.AttrARN), &arn)
+			response.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)
+
+			if got, want := arn.ValueString(), testCase.expectedARN; got != want {
+				t.Errorf("arn = %v, want %v", got, want)
+			}
+			if got, want := region.ValueString(), testCase.expectedRegion; got != want {
+				t.Errorf("region = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceRegionOverrideImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	w, s := newTestRegionWrappedResource(ctx, t)
+
+	response := resource.ImportStateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil), Schema: s},
+	}
+
+	w.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "test@eu-west-1"}, &response) //lintignore:AWSAT003
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	var id, region fwtypes.String
+	response.State.GetAttribute(ctx, path.Root(names.AttrID), &id)
+	response.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)
+
+	if got, want := id.ValueString(), "test"; got != want {
+		t.Errorf("id = %v, want %v", got, want)
+	}
+	if got, want := region.ValueString(), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("region = %v, want %v", got, want)
+	}
+}
+
+func TestSupportsRegionOverride(t *testing.T) {
+	t.Parallel()
+
+	attributes := map[string]schema.Attribute{
+		names.AttrName: schema.StringAttribute{Optional: true},
+	}
+	attributesWithRegion := map[string]schema.Attribute{
+		names.AttrRegion: schema.StringAttribute{Optional: true},
+	}
+	blocksWithRegion := map[string]schema.Block{
+		names.AttrRegion: schema.SingleNestedBlock{},
+	}
+
+	if !SupportsRegionOverride[schema.Attribute, schema.Block](names.SQS, attributes, nil) {
+		t.Errorf("SupportsRegionOverride(%s) = false, want true", names.SQS)
+	}
+	if SupportsRegionOverride[schema.Attribute, schema.Block](names.IAM, attributes, nil) {
+		t.Errorf("SupportsRegionOverride(%s) = true, want false", names.IAM)
+	}
+	if SupportsRegionOverride[schema.Attribute, schema.Block](names.SQS, attributesWithRegion, nil) {
+		t.Errorf("SupportsRegionOverride(%s) with region attribute = true, want false", names.SQS)
+	}
+	if SupportsRegionOverride(names.SQS, attributes, blocksWithRegion) {
+		t.Errorf("SupportsRegionOverride(%s) with region block = true, want false", names.SQS)
+	}
+}
+
+func TestFactoryErrorResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := newFactoryErrorResource("aws_test_region", schema.Schema{}, errors.New("test error"))
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+		t.Error("no region attribute in schema")
+	}
+
+	var configureResponse resource.ConfigureResponse
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: &conns.AWSClient{}}, &configureResponse)
+
+	if !configureResponse.Diagnostics.HasError() {
+		t.Error("Configure: expected error, got none")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingInterceptor records a span for each CRUD call.
+// AWS API calls made by the CRUD handler are recorded as child spans.
+// It must be the last interceptor in the chain so that a span is only started
+// when the handler is guaranteed to run, and is then always ended.
+type tracingInterceptor struct {
+	typeName string
+}
+
+func (r tracingInterceptor) run(ctx context.Context, state *tfsdk.State, when when, operation string, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var servicePackageName string
+		if inContext, ok := conns.FromContext(ctx); ok {
+			servicePackageName = inContext.ServicePackageName
+		}
+
+		ctx, _ = tracing.StartResourceSpan(ctx, servicePackageName, r.typeName, operation)
+	case Finally:
+		var id fwtypes.String
+		if state != nil && !state.Raw.IsNull() {
+			// Not all resources have an "id" attribute.
+			state.GetAttribute(ctx, path.Root(names.AttrID), &id)
+		}
+
+		tracing.EndSpan(trace.SpanFromContext(ctx), id.ValueString(), fwdiag.DiagnosticsError(diags))
+	}
+
+	return ctx, diags
+}
+
+// tracingDataSourceInterceptor records a span for each data source Read call.
+type tracingDataSourceInterceptor struct {
+	tracingInterceptor
+}
+
+func (r tracingDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, &response.State, when, "Read", diags)
+}
+
+// tracingResourceInterceptor records a span for each resource CRUD call.
+type tracingResourceInterceptor struct {
+	tracingInterceptor
+}
+
+func (r tracingResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, &response.State, when, "Create", diags)
+}
+
+func (r tracingResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, &response.State, when, "Read", diags)
+}
+
+func (r tracingResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, &response.State, when, "Update", diags)
+}
+
+func (r tracingResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, &request.State, when, "Delete", diags)
+}
@@ -79,6 +79,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -110,7 +125,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+		diags = f(ctx, d, metaForRegion(ctx, meta))
 
 		if diags.HasError() {
 			when = OnError
@@ -153,6 +168,8 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// regionOverride is set if the resource supports the per-resource `region` override.
+	regionOverride bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -175,7 +192,20 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		// An import ID may have a Region suffix, e.g. "vpc-12345678@eu-west-1".
+		if r.regionOverride {
+			if id, region := conns.ParseImportIDRegion(d.Id()); region != "" {
+				d.SetId(id)
+				if err := d.Set(names.AttrRegion, region); err != nil {
+					return nil, err
+				}
+				if v, ok := meta.(*conns.AWSClient); ok {
+					v.SetRegionInContext(ctx, region)
+				}
+			}
+		}
+
+		return f(ctx, d, metaForRegion(ctx, meta))
 	}
 }
 
@@ -183,7 +213,15 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		if r.regionOverride {
+			if v, ok := d.Get(names.AttrRegion).(string); ok {
+				if client, ok := meta.(*conns.AWSClient); ok {
+					client.SetRegionInContext(ctx, v)
+				}
+			}
+		}
+
+		return f(ctx, d, metaForRegion(ctx, meta))
 	}
 }
 
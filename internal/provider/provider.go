@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -21,11 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -65,6 +68,48 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"override": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with settings to change the default tags of specific resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag keys to not apply to matching resources.",
+									},
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, e.g. `aws_instance`, that the override applies to.",
+									},
+									"services": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+										},
+										Description: "Service keys, as used in the `endpoints` configuration block, that the override applies to.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to add to matching resources' default tags",
+									},
+								},
+							},
+						},
+						"precedence": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.DefaultTagsPrecedence](),
+							Description:      "Whether resource tags or default tags take precedence when both have the same key.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -152,12 +197,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"record_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"replay_file"},
+				Description: "Path to a file to which all AWS API traffic is recorded. " +
+					"Credentials and secret values are redacted from the recording.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"replay_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"record_file"},
+				Description: "Path to a file, previously written via `record_file`, from which all AWS API traffic is replayed. " +
+					"No requests are sent to AWS and no credentials are required.",
+			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with settings to require tags on all taggable resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions, one of which the tag's value must fully match.",
+						},
+						names.AttrKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The key of the required tag.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_instance`, that must have the tag. Defaults to all taggable resource types.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +274,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_rate_limits": serviceRateLimitsSchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -288,9 +378,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfigForResource(servicePackageName, typeName), v.IgnoreTagsConfig, nil)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -298,6 +388,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if SupportsRegionOverride(servicePackageName, r.SchemaMap()) {
+				addRegionOverrideAttribute(r, true)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -322,6 +422,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if tracing.Enabled() {
+				interceptors = append(interceptors, interceptorItem{
+					when: Before | Finally,
+					why:  Read,
+					interceptor: tracingInterceptor{
+						typeName: typeName,
+					},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -363,11 +473,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			// Required tags are only enforced for resources that have opted in to transparent tagging.
+			taggable := v.Tags != nil
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					var requiredTagsConfig *tftags.RequiredConfig
+					if taggable {
+						requiredTagsConfig = v.RequiredTagsConfig.ForResource(typeName)
+					}
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfigForResource(servicePackageName, typeName), v.IgnoreTagsConfig, requiredTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -375,6 +492,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			regionOverride := SupportsRegionOverride(servicePackageName, r.SchemaMap())
+			if regionOverride {
+				addRegionOverrideAttribute(r, false)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -410,9 +538,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if tracing.Enabled() {
+				interceptors = append(interceptors, interceptorItem{
+					when: Before | Finally,
+					why:  AllOps,
+					interceptor: tracingInterceptor{
+						typeName: typeName,
+					},
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				regionOverride:   regionOverride,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -498,6 +637,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v, ok := d.Get("record_file").(string); ok && v != "" {
+		config.RecordFile = v
+	}
+
+	if v, ok := d.Get("replay_file").(string); ok && v != "" {
+		config.ReplayFile = v
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -514,14 +661,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		config.AssumeRole = expandAssumeRoles(ctx, v.([]interface{}))
+		for i, assumeRole := range config.AssumeRole {
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -544,6 +694,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]interface{})) > 0 {
+		serviceRateLimits, dx := expandServiceRateLimits(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceRateLimits = serviceRateLimits
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -571,6 +730,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 {
+		requiredTagsConfig, dx := expandRequiredTags(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RequiredTagsConfig = requiredTagsConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -605,9 +773,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume, in order, prior to making API calls. Each role is assumed using the credentials of the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -752,6 +920,53 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func serviceRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate of AWS API calls made to individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_concurrency": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of concurrent requests to the service's API.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The maximum number of requests per second to the service's API.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+					Description:  "The service key, as used in the `endpoints` configuration block.",
+				},
+			},
+		},
+	}
+}
+
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) []awsbase.AssumeRole {
+	var assumeRoles []awsbase.AssumeRole
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v := expandAssumeRole(ctx, tfMap); v != nil {
+			assumeRoles = append(assumeRoles, *v)
+		}
+	}
+
+	return assumeRoles
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -845,6 +1060,22 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["override"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			defaultConfig.Overrides = append(defaultConfig.Overrides, expandDefaultTagsOverride(ctx, tfMap))
+		}
+	}
+
+	if v, ok := tfMap["precedence"].(string); ok && v != "" {
+		defaultConfig.Precedence = tftags.DefaultTagsPrecedence(v)
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -852,6 +1083,34 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandDefaultTagsOverride(ctx context.Context, tfMap map[string]interface{}) tftags.DefaultTagsOverride {
+	override := tftags.DefaultTagsOverride{}
+
+	if v, ok := tfMap["exclude_keys"].(*schema.Set); ok {
+		override.ExcludeKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+		override.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["services"].(*schema.Set); ok {
+		for _, service := range flex.ExpandStringValueSet(v) {
+			// Service keys may be aliases, e.g. "lex" for "lexmodels"; normalize to the provider package name.
+			if pkg, err := names.ProviderPackageForAlias(service); err == nil {
+				service = pkg
+			}
+			override.Services = append(override.Services, service)
+		}
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+		override.Tags = tftags.New(ctx, v)
+	}
+
+	return override
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1129,47 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRequiredTags(_ context.Context, tfList []interface{}) (*tftags.RequiredConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	requiredTagsPath := cty.GetAttrPath("required_tags")
+	requiredConfig := &tftags.RequiredConfig{}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		requiredTag := tftags.RequiredTag{
+			Key: tfMap[names.AttrKey].(string),
+		}
+
+		if v, ok := tfMap["allowed_values"].([]interface{}); ok {
+			for j, pattern := range flex.ExpandStringValueList(v) {
+				// Allowed values must match the whole tag value.
+				re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+
+				if err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeError(requiredTagsPath.IndexInt(i).GetAttr("allowed_values").IndexInt(j), err.Error()))
+					continue
+				}
+
+				requiredTag.AllowedValues = append(requiredTag.AllowedValues, re)
+			}
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			requiredTag.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+	}
+
+	return requiredConfig, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -975,6 +1275,49 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 	return endpoints, diags
 }
 
+func expandServiceRateLimits(_ context.Context, tfList []interface{}) (map[string]conns.ServiceRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceRateLimitsPath := cty.GetAttrPath("service_rate_limits")
+	serviceRateLimits := make(map[string]conns.ServiceRateLimit)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		servicePath := serviceRateLimitsPath.IndexInt(i).GetAttr("service")
+		service := tfMap["service"].(string)
+
+		pkg, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeError(servicePath, err.Error()))
+			continue
+		}
+
+		if _, ok := serviceRateLimits[pkg]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(servicePath, "Duplicate rate limit for service %q.", service))
+			continue
+		}
+
+		serviceRateLimit := conns.ServiceRateLimit{}
+
+		if v, ok := tfMap["max_concurrency"].(int); ok {
+			serviceRateLimit.MaxConcurrency = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			serviceRateLimit.RequestsPerSecond = v
+		}
+
+		serviceRateLimits[pkg] = serviceRateLimit
+	}
+
+	return serviceRateLimits, diags
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
@@ -8,10 +8,15 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +64,202 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		tfList        []interface{}
+		expected      map[string]conns.ServiceRateLimit
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			expected: map[string]conns.ServiceRateLimit{},
+		},
+		"package names and aliases": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"max_concurrency":     0,
+					"requests_per_second": 5.0,
+					"service":             "route53",
+				},
+				map[string]interface{}{
+					"max_concurrency":     2,
+					"requests_per_second": 0.0,
+					"service":             "cloudwatchlogs",
+				},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				names.Logs: {
+					MaxConcurrency: 2,
+				},
+				names.Route53: {
+					RequestsPerSecond: 5,
+				},
+			},
+		},
+		"duplicate service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"max_concurrency":     1,
+					"requests_per_second": 0.0,
+					"service":             "logs",
+				},
+				map[string]interface{}{
+					"max_concurrency":     2,
+					"requests_per_second": 0.0,
+					"service":             "cloudwatchlogs",
+				},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				names.Logs: {
+					MaxConcurrency: 1,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(
+					cty.GetAttrPath("service_rate_limits").IndexInt(1).GetAttr("service"),
+					`Duplicate rate limit for service "cloudwatchlogs".`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandServiceRateLimits(ctx, testCase.tfList)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		tfList   []interface{}
+		expected []awsbase.AssumeRole
+	}{
+		"empty": {},
+		"empty block": {
+			tfList: []interface{}{nil},
+		},
+		"single": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"duration":     "1h",
+					"role_arn":     "arn:aws:iam::111111111111:role/Hub",
+					"session_name": "hub",
+				},
+			},
+			expected: []awsbase.AssumeRole{
+				{
+					Duration:    1 * time.Hour,
+					RoleARN:     "arn:aws:iam::111111111111:role/Hub",
+					SessionName: "hub",
+				},
+			},
+		},
+		"chain": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"role_arn":     "arn:aws:iam::111111111111:role/Hub",
+					"session_name": "hub",
+				},
+				map[string]interface{}{
+					"external_id":  "ExternalID",
+					"role_arn":     "arn:aws:iam::222222222222:role/Workload",
+					"session_name": "workload",
+					"tags": map[string]interface{}{
+						"Team": "platform",
+					},
+				},
+			},
+			expected: []awsbase.AssumeRole{
+				{
+					RoleARN:     "arn:aws:iam::111111111111:role/Hub",
+					SessionName: "hub",
+				},
+				{
+					ExternalID:  "ExternalID",
+					RoleARN:     "arn:aws:iam::222222222222:role/Workload",
+					SessionName: "workload",
+					Tags: map[string]string{
+						"Team": "platform",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandAssumeRoles(ctx, testCase.tfList)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRequiredTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tfList := []interface{}{
+		map[string]interface{}{
+			"allowed_values": []interface{}{"dev|prod"},
+			names.AttrKey:    "Environment",
+			"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_instance"}),
+		},
+	}
+
+	got, diags := expandRequiredTags(ctx, tfList)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := len(got.Tags), 1; got != want {
+		t.Fatalf("got %d required tags, want %d", got, want)
+	}
+
+	requiredTag := got.Tags[0]
+
+	if diff := cmp.Diff(requiredTag.ResourceTypes, []string{"aws_instance"}); diff != "" {
+		t.Errorf("unexpected resource types difference: %s", diff)
+	}
+
+	// Allowed values must match the whole tag value.
+	for value, want := range map[string]bool{
+		"dev":         true,
+		"prod":        true,
+		"development": false,
+		"preprod":     false,
+	} {
+		if got := requiredTag.AllowedValues[0].MatchString(value); got != want {
+			t.Errorf("allowed value match for %q = %t, want %t", value, got, want)
+		}
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
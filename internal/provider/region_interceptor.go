@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SupportsRegionOverride returns whether the resource or data source can be given a per-resource `region` override.
+// Resources that already define a top-level `region` attribute keep their own semantics.
+func SupportsRegionOverride(servicePackageName string, schema map[string]*schema.Schema) bool {
+	if names.IsGlobal(servicePackageName) {
+		return false
+	}
+
+	if _, ok := schema[names.AttrRegion]; ok {
+		return false
+	}
+
+	return true
+}
+
+// addRegionOverrideAttribute adds the `region` attribute to the resource or data source's schema.
+func addRegionOverrideAttribute(r *schema.Resource, isDataSource bool) {
+	regionSchema := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     !isDataSource,
+		ValidateFunc: verify.ValidRegionName,
+	}
+
+	// Schema maps may be shared between resource instances, e.g. package-level variables, so are copied rather than modified.
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := maps.Clone(f())
+			s[names.AttrRegion] = regionSchema
+			return s
+		}
+	} else {
+		s := maps.Clone(r.Schema)
+		s[names.AttrRegion] = regionSchema
+		r.Schema = s
+	}
+}
+
+// metaForRegion returns the AWSClient for the Region that API calls made with the specified Context are sent to,
+// so that values derived from the AWSClient's Region, e.g. ARNs, are consistent with any per-resource Region override.
+func metaForRegion(ctx context.Context, meta any) any {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		return v.ForRegion(v.AwsRegion(ctx))
+	}
+
+	return meta
+}
+
+// regionInterceptor implements the per-resource `region` override.
+// The CRUD handler is passed the AWSClient for the overriding Region.
+// It must be the first interceptor in the chain so that all other interceptors see the override.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if _, ok := conns.FromContext(ctx); !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if v, ok := d.Get(names.AttrRegion).(string); ok {
+			meta.(*conns.AWSClient).SetRegionInContext(ctx, v)
+		}
+	case After:
+		// Set the effective Region in state.
+		if why != Delete && d.Id() != "" {
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).AwsRegion(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
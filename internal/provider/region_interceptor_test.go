@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSupportsRegionOverride(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		names.AttrName: {Type: schema.TypeString, Optional: true},
+	}
+	sWithRegion := map[string]*schema.Schema{
+		names.AttrRegion: {Type: schema.TypeString, Optional: true},
+	}
+
+	if !SupportsRegionOverride(names.SQS, s) {
+		t.Errorf("SupportsRegionOverride(%s) = false, want true", names.SQS)
+	}
+	if SupportsRegionOverride(names.IAM, s) {
+		t.Errorf("SupportsRegionOverride(%s) = true, want false", names.IAM)
+	}
+	if SupportsRegionOverride(names.SQS, sWithRegion) {
+		t.Errorf("SupportsRegionOverride(%s) with region attribute = true, want false", names.SQS)
+	}
+}
+
+func TestAddRegionOverrideAttributeSharedSchema(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		names.AttrName: {Type: schema.TypeString, Optional: true},
+	}
+
+	for _, isDataSource := range []bool{false, true} {
+		r := &schema.Resource{Schema: s}
+		addRegionOverrideAttribute(r, isDataSource)
+
+		if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+			t.Errorf("region attribute not added (data source: %t)", isDataSource)
+		}
+
+		r = &schema.Resource{SchemaFunc: func() map[string]*schema.Schema { return s }}
+		addRegionOverrideAttribute(r, isDataSource)
+
+		if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+			t.Errorf("region attribute not added using SchemaFunc (data source: %t)", isDataSource)
+		}
+	}
+
+	if _, ok := s[names.AttrRegion]; ok {
+		t.Error("shared schema map modified")
+	}
+}
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {Type: schema.TypeString, Optional: true},
+		},
+	}
+	addRegionOverrideAttribute(r, false)
+
+	meta := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := map[string]struct {
+		region   string
+		expected string
+	}{
+		"no override": {
+			expected: "us-west-2", //lintignore:AWSAT003
+		},
+		"override": {
+			region:   "eu-west-1", //lintignore:AWSAT003
+			expected: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), names.SQS, "Queue", "aws_sqs_queue")
+			d := schema.TestResourceDataRaw(t, r.SchemaMap(), map[string]any{
+				names.AttrRegion: testCase.region,
+			})
+			d.SetId("test")
+
+			var diags diag.Diagnostics
+			ctx, diags = regionInterceptor{}.run(ctx, d, meta, Before, Read, diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := meta.AwsRegion(ctx), testCase.expected; got != want {
+				t.Errorf("AwsRegion = %v, want %v", got, want)
+			}
+
+			_, diags = regionInterceptor{}.run(ctx, d, meta, After, Read, diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expected; got != want {
+				t.Errorf("region = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestInterceptedHandlerRegionOverride(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {Type: schema.TypeString, Optional: true},
+		},
+	}
+	addRegionOverrideAttribute(r, false)
+
+	meta := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return conns.NewResourceContext(ctx, names.SQS, "Queue", "aws_sqs_queue")
+	}
+	interceptors := interceptorItems{
+		{
+			when:        Before | After,
+			why:         AllOps,
+			interceptor: regionInterceptor{},
+		},
+	}
+
+	testCases := map[string]struct {
+		region   string
+		expected string
+	}{
+		"no override": {
+			expected: "us-west-2", //lintignore:AWSAT003
+		},
+		"override": {
+			region:   "eu-west-1", //lintignore:AWSAT003
+			expected: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			f := interceptedHandler(bootstrapContext, interceptors, func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				got = meta.(*conns.AWSClient).Region
+				return nil
+			}, Read)
+
+			d := schema.TestResourceDataRaw(t, r.SchemaMap(), map[string]any{
+				names.AttrRegion: testCase.region,
+			})
+			d.SetId("test")
+
+			if diags := f(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("handler's AWSClient Region = %v, want %v", got, want)
+			}
+		})
+	}
+}
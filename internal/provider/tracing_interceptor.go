@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingInterceptor records a span for each CRUD call.
+// AWS API calls made by the CRUD handler are recorded as child spans.
+// It must be the last interceptor in the chain so that a span is only started
+// when the handler is guaranteed to run, and is then always ended.
+type tracingInterceptor struct {
+	typeName string
+}
+
+func (r tracingInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var servicePackageName string
+		if inContext, ok := conns.FromContext(ctx); ok {
+			servicePackageName = inContext.ServicePackageName
+		}
+
+		ctx, _ = tracing.StartResourceSpan(ctx, servicePackageName, r.typeName, why.String())
+	case Finally:
+		tracing.EndSpan(trace.SpanFromContext(ctx), d.Id(), sdkdiag.DiagnosticsError(diags))
+	}
+
+	return ctx, diags
+}
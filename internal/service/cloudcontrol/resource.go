@@ -25,10 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
-	"github.com/mattbaird/jsonpatch"
 )
 
 // @SDKResource("aws_cloudcontrolapi_resource", name="Resource")
@@ -47,8 +45,9 @@ func resourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: desiredStateDiffSuppress,
 			},
 			names.AttrProperties: {
 				Type:     schema.TypeString,
@@ -92,9 +91,15 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
 
 	typeName := d.Get("type_name").(string)
+	desiredState, err := desiredStateWithoutReadOnlyProperties(d.Get(names.AttrSchema).(string), d.Get("desired_state").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Cloud Control API (%s) Resource: %s", typeName, err)
+	}
+
 	input := &cloudcontrol.CreateResourceInput{
 		ClientToken:  aws.String(id.UniqueId()),
-		DesiredState: aws.String(d.Get("desired_state").(string)),
+		DesiredState: aws.String(desiredState),
 		TypeName:     aws.String(typeName),
 	}
 
@@ -165,7 +170,7 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		patchDocument, err := patchDocument(d.Get(names.AttrSchema).(string), oldRaw.(string), newRaw.(string))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating JSON Patch: %s", err)
@@ -242,23 +247,28 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
-
-	resourceSchema := diff.Get(names.AttrSchema).(string)
+	// A schema set in configuration is always used.
+	if v := diff.GetRawConfig().GetAttr(names.AttrSchema); v.IsKnown() && !v.IsNull() {
+		return nil
+	}
 
-	if resourceSchema != "" {
+	// Refetch the schema if the resource type version changes.
+	if diff.Get(names.AttrSchema).(string) != "" && !diff.HasChange("type_version_id") {
 		return nil
 	}
 
+	awsClient := meta.(*conns.AWSClient)
 	typeName := diff.Get("type_name").(string)
+	typeVersionID := diff.Get("type_version_id").(string)
+	schemas := newCachingTypeSchemaProvider(&registryTypeSchemaProvider{conn: awsClient.CloudFormationClient(ctx)}, &typeSchemaCache, awsClient.AccountID+"/"+awsClient.Region)
 
-	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+	typeSchema, err := schemas.TypeSchema(ctx, typeName, typeVersionID)
 
 	if err != nil {
 		return fmt.Errorf("reading CloudFormation Type (%s): %w", typeName, err)
 	}
 
-	if err := diff.SetNew(names.AttrSchema, output.Schema); err != nil {
+	if err := diff.SetNew(names.AttrSchema, typeSchema); err != nil {
 		return fmt.Errorf("setting schema New: %w", err)
 	}
 
@@ -267,7 +277,7 @@ func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.Re
 
 func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	oldDesiredStateRaw, newDesiredStateRaw := diff.GetChange("desired_state")
-	newSchema := diff.Get(names.AttrSchema).(string)
+	typeSchema := diff.Get(names.AttrSchema).(string)
 
 	newDesiredState, ok := newDesiredStateRaw.(string)
 
@@ -275,21 +285,15 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("unexpected new desired_state value type: %T", newDesiredStateRaw)
 	}
 
-	// desired_state can be empty if unknown
-	if newDesiredState == "" {
+	// desired_state or schema can be empty if unknown
+	if newDesiredState == "" || typeSchema == "" {
 		return nil
 	}
 
-	newSchema, err := cfschema.Sanitize(newSchema)
-
-	if err != nil {
-		return fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
-	}
-
-	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(newSchema)
+	cfResourceSchema, cfResource, err := parseTypeSchema(typeSchema)
 
 	if err != nil {
-		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+		return err
 	}
 
 	if err := cfResourceSchema.ValidateConfigurationDocument(newDesiredState); err != nil {
@@ -301,29 +305,42 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	cfResource, err := cfResourceSchema.Resource()
+	patches, err := desiredStatePatches(cfResource, oldDesiredStateRaw.(string), newDesiredState)
 
 	if err != nil {
-		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+		return err
+	}
+
+	if requiresReplacement(cfResource, patches) {
+		if err := diff.ForceNew("desired_state"); err != nil {
+			return fmt.Errorf("setting desired_state ForceNew: %w", err)
+		}
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	return nil
+}
 
-	if err != nil {
-		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
+// desiredStateDiffSuppress suppresses differences in desired_state that are only in formatting or in read-only properties.
+func desiredStateDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
 	}
 
-	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
-			if err := diff.ForceNew("desired_state"); err != nil {
-				return fmt.Errorf("setting desired_state ForceNew: %w", err)
-			}
+	typeSchema := d.Get(names.AttrSchema).(string)
 
-			break
-		}
+	if typeSchema == "" {
+		return false
 	}
 
-	return nil
+	_, cfResource, err := parseTypeSchema(typeSchema)
+
+	if err != nil {
+		return false
+	}
+
+	patches, err := desiredStatePatches(cfResource, old, new)
+
+	return err == nil && len(patches) == 0
 }
 
 func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*types.ResourceDescription, error) {
@@ -429,9 +446,36 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 	return nil, err
 }
 
-// patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
-func patchDocument(old, new string) (string, error) {
-	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
+// desiredStateWithoutReadOnlyProperties returns `desiredState` without any read-only properties
+// of the resource type described by `typeSchema`.
+func desiredStateWithoutReadOnlyProperties(typeSchema, desiredState string) (string, error) {
+	if typeSchema == "" {
+		return desiredState, nil
+	}
+
+	_, cfResource, err := parseTypeSchema(typeSchema)
+
+	if err != nil {
+		return "", err
+	}
+
+	return removeReadOnlyProperties(cfResource, desiredState)
+}
+
+// patchDocument returns a JSON Patch document describing the difference between `old` and `new`,
+// ignoring any read-only properties of the resource type described by `typeSchema`.
+func patchDocument(typeSchema, old, new string) (string, error) {
+	cfResource := &cfschema.Resource{}
+
+	if typeSchema != "" {
+		var err error
+
+		if _, cfResource, err = parseTypeSchema(typeSchema); err != nil {
+			return "", err
+		}
+	}
+
+	patch, err := desiredStatePatches(cfResource, old, new)
 
 	if err != nil {
 		return "", err
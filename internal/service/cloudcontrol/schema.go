@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/mattbaird/jsonpatch"
+)
+
+// typeSchemaProvider provides CloudFormation resource type schemas.
+type typeSchemaProvider interface {
+	TypeSchema(ctx context.Context, typeName, typeVersionID string) (string, error)
+}
+
+// registryTypeSchemaProvider provides resource type schemas from the CloudFormation registry.
+type registryTypeSchemaProvider struct {
+	conn *cloudformation.Client
+}
+
+func (p *registryTypeSchemaProvider) TypeSchema(ctx context.Context, typeName, typeVersionID string) (string, error) {
+	output, err := tfcloudformation.FindTypeByNameAndVersionID(ctx, p.conn, typeName, typeVersionID)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.Schema), nil
+}
+
+// typeSchemaCache holds the resource type schemas fetched by all provider instances.
+var typeSchemaCache sync.Map
+
+// cachingTypeSchemaProvider caches the resource type schemas provided by another provider.
+// A type version's schema doesn't change, so fetching each schema once avoids DescribeType throttling
+// when many resources use the same type.
+type cachingTypeSchemaProvider struct {
+	cache    *sync.Map
+	provider typeSchemaProvider
+	// scope distinguishes private types, which are registered per account and Region.
+	scope string
+}
+
+func newCachingTypeSchemaProvider(provider typeSchemaProvider, cache *sync.Map, scope string) *cachingTypeSchemaProvider {
+	return &cachingTypeSchemaProvider{
+		cache:    cache,
+		provider: provider,
+		scope:    scope,
+	}
+}
+
+func (p *cachingTypeSchemaProvider) TypeSchema(ctx context.Context, typeName, typeVersionID string) (string, error) {
+	key := p.scope + "/" + typeName + "/" + typeVersionID
+
+	if v, ok := p.cache.Load(key); ok {
+		return v.(string), nil
+	}
+
+	typeSchema, err := p.provider.TypeSchema(ctx, typeName, typeVersionID)
+
+	if err != nil {
+		return "", err
+	}
+
+	p.cache.Store(key, typeSchema)
+
+	return typeSchema, nil
+}
+
+// parseTypeSchema parses a CloudFormation resource type schema.
+func parseTypeSchema(typeSchema string) (*cfschema.ResourceJsonSchema, *cfschema.Resource, error) {
+	typeSchema, err := cfschema.Sanitize(typeSchema)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resourceSchema, err := cfschema.NewResourceJsonSchemaDocument(typeSchema)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resource, err := resourceSchema.Resource()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return resourceSchema, resource, nil
+}
+
+// removeReadOnlyProperties returns the desired state with any of the resource type's read-only properties removed.
+// Read-only properties are returned by the Cloud Control API but cannot be set.
+func removeReadOnlyProperties(resource *cfschema.Resource, desiredState string) (string, error) {
+	if len(resource.ReadOnlyProperties) == 0 {
+		return desiredState, nil
+	}
+
+	var v any
+
+	if err := json.Unmarshal([]byte(desiredState), &v); err != nil {
+		return "", err
+	}
+
+	for _, pointer := range resource.ReadOnlyProperties {
+		v = removeProperty(v, pointer.Path())
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// removeProperty removes the property at the specified path.
+// A `*` path segment matches every element of an array.
+func removeProperty(v any, path []string) any {
+	if len(path) == 0 {
+		return v
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		if len(path) == 1 {
+			delete(v, path[0])
+		} else if child, ok := v[path[0]]; ok {
+			v[path[0]] = removeProperty(child, path[1:])
+		}
+	case []any:
+		if path[0] == "*" {
+			for i, child := range v {
+				v[i] = removeProperty(child, path[1:])
+			}
+		} else if i, err := strconv.Atoi(path[0]); err == nil && i >= 0 && i < len(v) {
+			if len(path) == 1 {
+				return append(v[:i], v[i+1:]...)
+			}
+			v[i] = removeProperty(v[i], path[1:])
+		}
+	}
+
+	return v
+}
+
+// desiredStatePatches returns the JSON Patch operations that update the old desired state to the new one,
+// ignoring any differences in read-only properties.
+func desiredStatePatches(resource *cfschema.Resource, old, new string) ([]jsonpatch.JsonPatchOperation, error) {
+	old, err := removeReadOnlyProperties(resource, old)
+
+	if err != nil {
+		return nil, fmt.Errorf("removing read-only properties from old desired_state: %w", err)
+	}
+
+	new, err = removeReadOnlyProperties(resource, new)
+
+	if err != nil {
+		return nil, fmt.Errorf("removing read-only properties from new desired_state: %w", err)
+	}
+
+	patches, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
+
+	if err != nil {
+		return nil, fmt.Errorf("creating desired_state JSON Patch: %w", err)
+	}
+
+	return patches, nil
+}
+
+// requiresReplacement returns whether any of the JSON Patch operations changes a create-only property.
+func requiresReplacement(resource *cfschema.Resource, patches []jsonpatch.JsonPatchOperation) bool {
+	for _, patch := range patches {
+		if resource.IsCreateOnlyPropertyPath(patch.Path) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+const testTypeSchema = `{
+  "typeName": "Example::Test::Resource",
+  "description": "Test resource",
+  "additionalProperties": false,
+  "properties": {
+    "Arn": {"type": "string"},
+    "Name": {"type": "string"},
+    "Description": {"type": "string"},
+    "Password": {"type": "string"},
+    "Rules": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "properties": {
+          "Id": {"type": "string"},
+          "Value": {"type": "string"}
+        }
+      }
+    }
+  },
+  "required": ["Name"],
+  "primaryIdentifier": ["/properties/Name"],
+  "createOnlyProperties": ["/properties/Name"],
+  "readOnlyProperties": ["/properties/Arn", "/properties/Rules/*/Id"],
+  "writeOnlyProperties": ["/properties/Password"]
+}`
+
+type stubTypeSchemaProvider struct {
+	calls int
+	mu    sync.Mutex
+}
+
+func (p *stubTypeSchemaProvider) TypeSchema(context.Context, string, string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls++
+
+	return testTypeSchema, nil
+}
+
+func TestCachingTypeSchemaProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stub := &stubTypeSchemaProvider{}
+	var cache sync.Map
+
+	for _, scope := range []string{"123456789012/us-west-2", "123456789012/us-west-2", "123456789012/us-east-1"} {
+		schemas := newCachingTypeSchemaProvider(stub, &cache, scope)
+
+		for _, typeVersionID := range []string{"", "00000001", ""} {
+			if _, err := schemas.TypeSchema(ctx, "Example::Test::Resource", typeVersionID); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if got, want := stub.calls, 4; got != want {
+		t.Errorf("type schema fetched %d times, want %d", got, want)
+	}
+}
+
+func TestDesiredStatePatches(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema, resource, err := parseTypeSchema(testTypeSchema)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		old                 string
+		new                 string
+		expectedPatches     int
+		expectedReplacement bool
+		expectInvalid       bool
+	}{
+		"reformatted": {
+			old: `{"Name":"test","Description":"one"}`,
+			new: `{ "Description": "one", "Name": "test" }`,
+		},
+		"read-only properties": {
+			old: `{"Name":"test","Rules":[{"Value":"a"}]}`,
+			new: `{"Arn":"arn:aws:example","Name":"test","Rules":[{"Id":"1","Value":"a"}]}`,
+		},
+		"updatable property": {
+			old:             `{"Name":"test","Description":"one"}`,
+			new:             `{"Name":"test","Description":"two"}`,
+			expectedPatches: 1,
+		},
+		"write-only property": {
+			old:             `{"Name":"test","Password":"one"}`,
+			new:             `{"Name":"test","Password":"two"}`,
+			expectedPatches: 1,
+		},
+		"write-only property added": {
+			old:             `{"Name":"test"}`,
+			new:             `{"Name":"test","Password":"one"}`,
+			expectedPatches: 1,
+		},
+		"create-only property": {
+			old:                 `{"Name":"test"}`,
+			new:                 `{"Name":"test2"}`,
+			expectedPatches:     1,
+			expectedReplacement: true,
+		},
+		"invalid": {
+			old:             `{"Name":"test"}`,
+			new:             `{"Name":"test","Unknown":"value"}`,
+			expectedPatches: 1,
+			expectInvalid:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := resourceSchema.ValidateConfigurationDocument(testCase.new)
+
+			if got, want := err != nil, testCase.expectInvalid; got != want {
+				t.Errorf("validation error %v, want error %t", err, want)
+			}
+
+			patches, err := desiredStatePatches(resource, testCase.old, testCase.new)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := len(patches), testCase.expectedPatches; got != want {
+				t.Errorf("got %d patches (%v), want %d", got, patches, want)
+			}
+
+			if got, want := requiresReplacement(resource, patches), testCase.expectedReplacement; got != want {
+				t.Errorf("requiresReplacement = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRemoveReadOnlyProperties(t *testing.T) {
+	t.Parallel()
+
+	_, resource, err := parseTypeSchema(testTypeSchema)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := removeReadOnlyProperties(resource, `{"Arn":"arn:aws:example","Name":"test","Rules":[{"Id":"1","Value":"a"},{"Value":"b"}]}`)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"Name":"test","Rules":[{"Value":"a"},{"Value":"b"}]}`; got != want {
+		t.Errorf("removeReadOnlyProperties = %s, want %s", got, want)
+	}
+}
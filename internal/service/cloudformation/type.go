@@ -323,6 +323,18 @@ func findTypeByName(ctx context.Context, conn *cloudformation.Client, name strin
 	return findType(ctx, conn, input)
 }
 
+func findTypeByNameAndVersionID(ctx context.Context, conn *cloudformation.Client, name, versionID string) (*cloudformation.DescribeTypeOutput, error) {
+	input := &cloudformation.DescribeTypeInput{
+		Type:     awstypes.RegistryTypeResource,
+		TypeName: aws.String(name),
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	return findType(ctx, conn, input)
+}
+
 func findType(ctx context.Context, conn *cloudformation.Client, input *cloudformation.DescribeTypeInput) (*cloudformation.DescribeTypeOutput, error) {
 	output, err := conn.DescribeType(ctx, input)
 
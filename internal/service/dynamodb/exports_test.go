@@ -11,6 +11,7 @@ var (
 	ResourceTable                       = resourceTable
 	ResourceTableExport                 = resourceTableExport
 	ResourceTableItem                   = resourceTableItem
+	ResourceTableItems                  = resourceTableItems
 	ResourceTableReplica                = resourceTableReplica
 	ResourceTag                         = resourceTag
 	ResourceResourcePolicy              = newResourcePolicyResource
@@ -18,6 +19,7 @@ var (
 	ARNForNewRegion                              = arnForNewRegion
 	ContributorInsightsParseResourceID           = contributorInsightsParseResourceID
 	ExpandTableItemAttributes                    = expandTableItemAttributes
+	ExpandTableItemJSONAttributes                = expandTableItemJSONAttributes
 	ExpandTableItemQueryKey                      = expandTableItemQueryKey
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
 	FindGlobalTableByName                        = findGlobalTableByName
@@ -28,6 +30,7 @@ var (
 	FindTableItemByTwoPartKey                    = findTableItemByTwoPartKey
 	FindTag                                      = findTag
 	FlattenTableItemAttributes                   = flattenTableItemAttributes
+	FlattenTableItemJSONAttributes               = flattenTableItemJSONAttributes
 	ListTags                                     = listTags
 	RegionFromARN                                = regionFromARN
 	ReplicaForRegion                             = replicaForRegion
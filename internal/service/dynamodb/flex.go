@@ -190,3 +190,89 @@ const (
 func unexpectedRawAttributeElementTypeError(v any, k string) error {
 	return fmt.Errorf("unexpected raw attribute element type (%T) for data type descriptor: %s", v, k)
 }
+
+// expandTableItemJSONAttributes converts an item in plain JSON, e.g. `{"id": "a", "count": 1}`, to attribute values.
+// JSON numbers, strings, booleans, nulls, arrays and objects are converted to the N, S, BOOL, NULL, L and M data types.
+func expandTableItemJSONAttributes(jsonStream string) (map[string]awstypes.AttributeValue, error) {
+	var m map[string]any
+	dec := json.NewDecoder(strings.NewReader(jsonStream))
+	dec.UseNumber()
+
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+
+	return tfmaps.ApplyToAllValuesWithError(m, attributeFromJSON)
+}
+
+func flattenTableItemJSONAttributes(apiObject map[string]awstypes.AttributeValue) (string, error) {
+	m, err := tfmaps.ApplyToAllValuesWithError(apiObject, jsonFromAttribute)
+	if err != nil {
+		return "", err
+	}
+
+	b := new(bytes.Buffer)
+	enc := json.NewEncoder(b)
+
+	if err := enc.Encode(m); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func attributeFromJSON(v any) (awstypes.AttributeValue, error) {
+	switch v := v.(type) {
+	case nil:
+		return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+	case bool:
+		return &awstypes.AttributeValueMemberBOOL{Value: v}, nil
+	case json.Number:
+		return &awstypes.AttributeValueMemberN{Value: v.String()}, nil
+	case string:
+		return &awstypes.AttributeValueMemberS{Value: v}, nil
+	case []any:
+		l, err := tfslices.ApplyToAllWithError(v, attributeFromJSON)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberL{Value: l}, nil
+	case map[string]any:
+		m, err := tfmaps.ApplyToAllValuesWithError(v, attributeFromJSON)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberM{Value: m}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected JSON value type: %T", v)
+}
+
+// jsonFromAttribute converts an attribute value to plain JSON.
+// Binary values are base64-encoded and sets are converted to arrays.
+func jsonFromAttribute(a awstypes.AttributeValue) (any, error) {
+	switch a := a.(type) {
+	case *awstypes.AttributeValueMemberB:
+		return itypes.Base64Encode(a.Value), nil
+	case *awstypes.AttributeValueMemberBOOL:
+		return a.Value, nil
+	case *awstypes.AttributeValueMemberBS:
+		return tfslices.ApplyToAll(a.Value, itypes.Base64Encode), nil
+	case *awstypes.AttributeValueMemberL:
+		return tfslices.ApplyToAllWithError(a.Value, jsonFromAttribute)
+	case *awstypes.AttributeValueMemberM:
+		return tfmaps.ApplyToAllValuesWithError(a.Value, jsonFromAttribute)
+	case *awstypes.AttributeValueMemberN:
+		return json.Number(a.Value), nil
+	case *awstypes.AttributeValueMemberNS:
+		return tfslices.ApplyToAll(a.Value, func(v string) json.Number { return json.Number(v) }), nil
+	case *awstypes.AttributeValueMemberNULL:
+		return nil, nil
+	case *awstypes.AttributeValueMemberS:
+		return a.Value, nil
+	case *awstypes.AttributeValueMemberSS:
+		return a.Value, nil
+	}
+
+	return nil, fmt.Errorf("unexpected attribute type: %T", a)
+}
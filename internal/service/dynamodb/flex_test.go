@@ -139,6 +139,169 @@ func TestExpandTableItemAttributes(t *testing.T) {
 	}
 }
 
+func TestExpandTableItemJSONAttributes(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input    string
+		expected map[string]awstypes.AttributeValue
+	}{
+		"BOOL": {
+			input: `{"attr":true}`,
+			expected: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberBOOL{
+					Value: true,
+				},
+			},
+		},
+		"L": {
+			input: `{"attr":["one",2]}`,
+			expected: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberL{
+					Value: []awstypes.AttributeValue{
+						&awstypes.AttributeValueMemberS{
+							Value: "one",
+						},
+						&awstypes.AttributeValueMemberN{
+							Value: "2",
+						},
+					},
+				},
+			},
+		},
+		"M": {
+			input: `{"attr":{"key":"value"}}`,
+			expected: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberM{
+					Value: map[string]awstypes.AttributeValue{
+						names.AttrKey: &awstypes.AttributeValueMemberS{
+							Value: names.AttrValue,
+						},
+					},
+				},
+			},
+		},
+		"N": {
+			input: `{"attr":12345678901234567890.5}`,
+			expected: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberN{
+					Value: "12345678901234567890.5",
+				},
+			},
+		},
+		"NULL": {
+			input: `{"attr":null}`,
+			expected: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberNULL{
+					Value: true,
+				},
+			},
+		},
+		"S": {
+			input: `{"attr":"value"}`,
+			expected: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberS{
+					Value: names.AttrValue,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tfdynamodb.ExpandTableItemJSONAttributes(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !maps.EqualFunc(actual, tc.expected, attributeValuesEqual) {
+				t.Fatalf("expected\n%s\ngot\n%s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenTableItemJSONAttributes(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		attrs    map[string]awstypes.AttributeValue
+		expected string
+	}{
+		"B": {
+			attrs: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberB{
+					Value: []byte("blob"),
+				},
+			},
+			expected: fmt.Sprintf(`{"attr":"%s"}`, base64.StdEncoding.EncodeToString([]byte("blob"))),
+		},
+		"M": {
+			attrs: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberM{
+					Value: map[string]awstypes.AttributeValue{
+						"count": &awstypes.AttributeValueMemberN{
+							Value: "12345678901234567890",
+						},
+						"enabled": &awstypes.AttributeValueMemberBOOL{
+							Value: false,
+						},
+						"none": &awstypes.AttributeValueMemberNULL{
+							Value: true,
+						},
+					},
+				},
+			},
+			expected: `{"attr":{"count":12345678901234567890,"enabled":false,"none":null}}`,
+		},
+		"NS": {
+			attrs: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberNS{
+					Value: []string{"1", "2"},
+				},
+			},
+			expected: `{"attr":[1,2]}`,
+		},
+		"SS": {
+			attrs: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberSS{
+					Value: []string{"one", "two"},
+				},
+			},
+			expected: `{"attr":["one","two"]}`,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tfdynamodb.FlattenTableItemJSONAttributes(tc.attrs)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			e, err := structure.NormalizeJsonString(tc.expected)
+			if err != nil {
+				t.Fatalf("normalizing expected JSON: %s", err)
+			}
+
+			a, err := structure.NormalizeJsonString(actual)
+			if err != nil {
+				t.Fatalf("normalizing returned JSON: %s", err)
+			}
+
+			if a != e {
+				t.Fatalf("expected\n%s\ngot\n%s", e, a)
+			}
+		})
+	}
+}
+
 func attributeValuesEqual(a, b awstypes.AttributeValue) bool {
 	switch a := a.(type) {
 	case *awstypes.AttributeValueMemberB:
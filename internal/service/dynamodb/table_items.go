@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	batchWriteItemMaxRequests = 25
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	batchGetItemMaxKeys = 100
+
+	tableItemsTimeout = 10 * time.Minute
+)
+
+const (
+	tableItemsFormatDynamoDBJSON = "DYNAMODB_JSON"
+	tableItemsFormatJSON         = "JSON"
+)
+
+func tableItemsFormat_Values() []string {
+	return []string{
+		tableItemsFormatDynamoDBJSON,
+		tableItemsFormatJSON,
+	}
+}
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(tableItemsTimeout),
+			Update: schema.DefaultTimeout(tableItemsTimeout),
+			Delete: schema.DefaultTimeout(tableItemsTimeout),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      tableItemsFormatDynamoDBJSON,
+				ValidateFunc: validation.StringInSlice(tableItemsFormat_Values(), false),
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:                  schema.TypeString,
+					ValidateFunc:          validation.StringIsJSON,
+					DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+					DiffSuppressOnRefresh: true,
+				},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	items, err := expandTableItems(d.Get("items").([]interface{}), d.Get(names.AttrFormat).(string), tableName, d.Get("hash_key").(string), d.Get("range_key").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, tableItemsPutRequests(items), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	format := d.Get(names.AttrFormat).(string)
+	tfList := d.Get("items").([]interface{})
+
+	items, err := expandTableItems(tfList, format, tableName, hashKey, rangeKey)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	found, err := findTableItemsByKeys(ctx, conn, tableName, tfslices.ApplyToAll(items, func(v tableItem) map[string]awstypes.AttributeValue {
+		return expandTableItemQueryKey(v.attributes, hashKey, rangeKey)
+	}), hashKey, rangeKey)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Items (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	// Items that have been deleted outside of Terraform are removed so that they are added again,
+	// and items that have been changed outside of Terraform are updated to their current values.
+	newList := make([]interface{}, 0, len(items))
+
+	for i, item := range items {
+		attributes, ok := found[item.id]
+
+		if !ok {
+			continue
+		}
+
+		if reflect.DeepEqual(attributes, item.attributes) {
+			newList = append(newList, tfList[i])
+			continue
+		}
+
+		v, err := flattenTableItem(attributes, format)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		newList = append(newList, v)
+	}
+
+	if err := d.Set("items", newList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting items: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	if d.HasChanges(names.AttrFormat, "items") {
+		tableName := d.Get(names.AttrTableName).(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+		oldFormat, newFormat := d.GetChange(names.AttrFormat)
+		oldList, newList := d.GetChange("items")
+
+		oldItems, err := expandTableItems(oldList.([]interface{}), oldFormat.(string), tableName, hashKey, rangeKey)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		newItems, err := expandTableItems(newList.([]interface{}), newFormat.(string), tableName, hashKey, rangeKey)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		puts, deletes := tableItemsChanges(oldItems, newItems)
+		requests := append(tableItemsPutRequests(puts), tableItemsDeleteRequests(deletes, hashKey, rangeKey)...)
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandTableItems(d.Get("items").([]interface{}), d.Get(names.AttrFormat).(string), tableName, hashKey, rangeKey)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Items: %s", d.Id())
+	err = batchWriteTableItems(ctx, conn, tableName, tableItemsDeleteRequests(items, hashKey, rangeKey), d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("items") || !d.NewValueKnown(names.AttrFormat) || !d.NewValueKnown("hash_key") || !d.NewValueKnown("range_key") {
+		return nil
+	}
+
+	_, err := expandTableItems(d.Get("items").([]interface{}), d.Get(names.AttrFormat).(string), d.Get(names.AttrTableName).(string), d.Get("hash_key").(string), d.Get("range_key").(string))
+
+	return err
+}
+
+// tableItem is an item in a table.
+type tableItem struct {
+	attributes map[string]awstypes.AttributeValue
+	// id identifies the item by its table name and key attribute values.
+	id string
+}
+
+// expandTableItems returns the items in the specified format.
+// An error is returned if any item is missing a key attribute, or if more than one item has the same key.
+func expandTableItems(tfList []interface{}, format, tableName, hashKey, rangeKey string) ([]tableItem, error) {
+	items := make([]tableItem, 0, len(tfList))
+	ids := make(map[string]int, len(tfList))
+
+	for i, v := range tfList {
+		s, ok := v.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("items.%d: empty item", i)
+		}
+
+		var attributes map[string]awstypes.AttributeValue
+		var err error
+
+		switch format {
+		case tableItemsFormatJSON:
+			attributes, err = expandTableItemJSONAttributes(s)
+		default:
+			attributes, err = expandTableItemAttributes(s)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("items.%d: %w", i, err)
+		}
+
+		for _, key := range []string{hashKey, rangeKey} {
+			if key == "" {
+				continue
+			}
+
+			if _, ok := attributes[key]; !ok {
+				return nil, fmt.Errorf("items.%d: missing key attribute %q", i, key)
+			}
+		}
+
+		id := tableItemCreateResourceID(tableName, hashKey, rangeKey, attributes)
+
+		if j, ok := ids[id]; ok {
+			return nil, fmt.Errorf("items.%d: same key as items.%d", i, j)
+		}
+
+		ids[id] = i
+		items = append(items, tableItem{
+			attributes: attributes,
+			id:         id,
+		})
+	}
+
+	return items, nil
+}
+
+func flattenTableItem(attributes map[string]awstypes.AttributeValue, format string) (string, error) {
+	switch format {
+	case tableItemsFormatJSON:
+		return flattenTableItemJSONAttributes(attributes)
+	default:
+		return flattenTableItemAttributes(attributes)
+	}
+}
+
+// tableItemsChanges returns the items to put, because they are new or changed, and the items to delete.
+func tableItemsChanges(oldItems, newItems []tableItem) ([]tableItem, []tableItem) {
+	old := make(map[string]tableItem, len(oldItems))
+	for _, item := range oldItems {
+		old[item.id] = item
+	}
+
+	var puts []tableItem
+
+	for _, item := range newItems {
+		if oldItem, ok := old[item.id]; !ok || !reflect.DeepEqual(oldItem.attributes, item.attributes) {
+			puts = append(puts, item)
+		}
+
+		delete(old, item.id)
+	}
+
+	var deletes []tableItem
+
+	// Preserve the configured order.
+	for _, item := range oldItems {
+		if _, ok := old[item.id]; ok {
+			deletes = append(deletes, item)
+		}
+	}
+
+	return puts, deletes
+}
+
+func tableItemsPutRequests(items []tableItem) []awstypes.WriteRequest {
+	return tfslices.ApplyToAll(items, func(v tableItem) awstypes.WriteRequest {
+		return awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: v.attributes,
+			},
+		}
+	})
+}
+
+func tableItemsDeleteRequests(items []tableItem, hashKey, rangeKey string) []awstypes.WriteRequest {
+	return tfslices.ApplyToAll(items, func(v tableItem) awstypes.WriteRequest {
+		return awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: expandTableItemQueryKey(v.attributes, hashKey, rangeKey),
+			},
+		}
+	})
+}
+
+var errUnprocessedItems = errors.New("unprocessed items")
+
+// batchWriteTableItems writes the requests in batches, retrying any unprocessed requests until the timeout expires.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	for _, chunk := range tfslices.Chunks(requests, batchWriteItemMaxRequests) {
+		requestItems := map[string][]awstypes.WriteRequest{
+			tableName: chunk,
+		}
+
+		_, err := tfresource.RetryWhen(ctx, timeout,
+			func() (interface{}, error) {
+				output, err := conn.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+					RequestItems: requestItems,
+				})
+
+				if err != nil {
+					return nil, err
+				}
+
+				if len(output.UnprocessedItems) > 0 {
+					requestItems = output.UnprocessedItems
+					return nil, fmt.Errorf("%d %w", len(output.UnprocessedItems[tableName]), errUnprocessedItems)
+				}
+
+				return output, nil
+			},
+			func(err error) (bool, error) {
+				if errors.Is(err, errUnprocessedItems) {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys returns the items with the specified keys, keyed by item ID.
+// Items that don't exist are omitted.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue, hashKey, rangeKey string) (map[string]map[string]awstypes.AttributeValue, error) {
+	items := make(map[string]map[string]awstypes.AttributeValue, len(keys))
+
+	for _, chunk := range tfslices.Chunks(keys, batchGetItemMaxKeys) {
+		requestItems := map[string]awstypes.KeysAndAttributes{
+			tableName: {
+				ConsistentRead: aws.Bool(true),
+				Keys:           chunk,
+			},
+		}
+
+		_, err := tfresource.RetryWhen(ctx, tableItemsTimeout,
+			func() (interface{}, error) {
+				input := &dynamodb.BatchGetItemInput{
+					RequestItems: requestItems,
+				}
+
+				output, err := conn.BatchGetItem(ctx, input)
+
+				if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+					return nil, &retry.NotFoundError{
+						LastError:   err,
+						LastRequest: input,
+					}
+				}
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, item := range output.Responses[tableName] {
+					items[tableItemCreateResourceID(tableName, hashKey, rangeKey, item)] = item
+				}
+
+				if len(output.UnprocessedKeys) > 0 {
+					requestItems = output.UnprocessedKeys
+					return nil, fmt.Errorf("%d %w", len(output.UnprocessedKeys[tableName].Keys), errUnprocessedItems)
+				}
+
+				return output, nil
+			},
+			func(err error) (bool, error) {
+				if errors.Is(err, errUnprocessedItems) {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
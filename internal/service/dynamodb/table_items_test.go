@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "DYNAMODB_JSON"),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_json(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// More items than fit in a single BatchWriteItem request.
+				Config: testAccTableItemsConfig_json(rName, 60, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "60"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_json(rName, 30, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 30),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "30"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items.0", `{"id":"item-0","index":0,"value":"two"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_duplicateKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableItemsConfig_duplicateKey(rName),
+				ExpectError: regexache.MustCompile(`items.1: same key as items.0`),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			n, err := strconv.Atoi(rs.Primary.Attributes["items.#"])
+			if err != nil {
+				return err
+			}
+
+			for i := 0; i < n; i++ {
+				v := rs.Primary.Attributes[fmt.Sprintf("items.%d", i)]
+
+				var attributes map[string]awstypes.AttributeValue
+				if rs.Primary.Attributes[names.AttrFormat] == "JSON" {
+					attributes, err = tfdynamodb.ExpandTableItemJSONAttributes(v)
+				} else {
+					attributes, err = tfdynamodb.ExpandTableItemAttributes(v)
+				}
+				if err != nil {
+					return err
+				}
+
+				key := tfdynamodb.ExpandTableItemQueryKey(attributes, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"])
+
+				_, err = tfdynamodb.FindTableItemByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrTableName], key)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DynamoDB Table Items %s item %d still exists.", rs.Primary.ID, i)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [
+    jsonencode({
+      id    = { S = "one" }
+      count = { N = "1" }
+    }),
+    jsonencode({
+      id   = { S = "two" }
+      tags = { SS = ["a", "b"] }
+    }),
+  ]
+}
+`)
+}
+
+func testAccTableItemsConfig_json(rName string, count int, value string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  format     = "JSON"
+
+  items = [for i in range(%[1]d) : jsonencode({
+    id    = "item-${i}"
+    index = i
+    value = %[2]q
+  })]
+}
+`, count, value))
+}
+
+func testAccTableItemsConfig_duplicateKey(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  format     = "JSON"
+
+  items = [
+    jsonencode({ id = "one", value = 1 }),
+    jsonencode({ id = "one", value = 2 }),
+  ]
+}
+`)
+}
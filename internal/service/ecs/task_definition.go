@@ -74,10 +74,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": containerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -462,10 +465,15 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get(names.AttrFamily).(string), err)
+	var definitions []*ecs.ContainerDefinition
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandContainerDefinitionBlocks(v.([]interface{}))
+	} else {
+		var err error
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get(names.AttrFamily).(string), err)
+		}
 	}
 
 	input := &ecs.RegisterTaskDefinitionInput{
@@ -617,6 +625,15 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("revision", taskDefinition.Revision)
 	d.Set("track_latest", d.Get("track_latest"))
 
+	// The typed form keeps the containers in the order in which they were registered.
+	// It's only set when it's already in state, as container_definition isn't Computed: setting it on import would force
+	// replacement of task definitions that are configured with container_definitions.
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		if err := d.Set("container_definition", flattenContainerDefinitionBlocks(taskDefinition.ContainerDefinitions)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+		}
+	}
+
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
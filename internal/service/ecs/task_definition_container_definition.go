@@ -0,0 +1,620 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// containerDefinitionSchema returns the schema of the typed container_definition block.
+// Values that ECS fills in when they are omitted are modelled as schema defaults so that
+// the state read back from the API matches the configuration exactly.
+func containerDefinitionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrEnvironment: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							names.AttrValue: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				names.AttrHealthCheck: {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrInterval: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      30,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      3,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      0,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							names.AttrTimeout: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      5,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": containerDefinitionSecretSchema(),
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+								Default:  false,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							// ECS sets the host port to the container port in awsvpc network mode.
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							names.AttrProtocol: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"secret": containerDefinitionSecretSchema(),
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func containerDefinitionSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"value_from": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandContainerDefinitionBlocks(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Cpu:       aws.Int64(int64(tfMap["cpu"].(int))),
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DependsOn = expandContainerDefinitionDependencies(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap[names.AttrEnvironment].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Environment = expandContainerDefinitionKeyValuePairs(v.List())
+		}
+
+		if v, ok := tfMap[names.AttrHealthCheck].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HealthCheck = expandContainerDefinitionHealthCheck(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LogConfiguration = expandContainerDefinitionLogConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MountPoints = expandContainerDefinitionMountPoints(v)
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+			apiObject.PortMappings = expandContainerDefinitionPortMappings(v)
+		}
+
+		if v, ok := tfMap["secret"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Secrets = expandContainerDefinitionSecrets(v.List())
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionDependencies(tfList []interface{}) []*ecs.ContainerDependency {
+	var apiObjects []*ecs.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ContainerDependency{
+			Condition:     aws.String(tfMap["condition"].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionKeyValuePairs(tfList []interface{}) []*ecs.KeyValuePair {
+	var apiObjects []*ecs.KeyValuePair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	return &ecs.HealthCheck{
+		Command:     flex.ExpandStringList(tfMap["command"].([]interface{})),
+		Interval:    aws.Int64(int64(tfMap[names.AttrInterval].(int))),
+		Retries:     aws.Int64(int64(tfMap["retries"].(int))),
+		StartPeriod: aws.Int64(int64(tfMap["start_period"].(int))),
+		Timeout:     aws.Int64(int64(tfMap[names.AttrTimeout].(int))),
+	}
+}
+
+func expandContainerDefinitionLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecretOptions = expandContainerDefinitionSecrets(v.List())
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionMountPoints(tfList []interface{}) []*ecs.MountPoint {
+	var apiObjects []*ecs.MountPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.MountPoint{
+			ContainerPath: aws.String(tfMap["container_path"].(string)),
+			ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+			SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	var apiObjects []*ecs.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+			Protocol:      aws.String(tfMap[names.AttrProtocol].(string)),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap[names.AttrName].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenContainerDefinitionBlocks(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"command":             aws.StringValueSlice(apiObject.Command),
+			"cpu":                 aws.Int64Value(apiObject.Cpu),
+			"depends_on":          flattenContainerDefinitionDependencies(apiObject.DependsOn),
+			"entry_point":         aws.StringValueSlice(apiObject.EntryPoint),
+			names.AttrEnvironment: flattenContainerDefinitionKeyValuePairs(apiObject.Environment),
+			"essential":           aws.BoolValue(apiObject.Essential),
+			"image":               aws.StringValue(apiObject.Image),
+			"memory":              aws.Int64Value(apiObject.Memory),
+			"memory_reservation":  aws.Int64Value(apiObject.MemoryReservation),
+			"mount_point":         flattenContainerDefinitionMountPoints(apiObject.MountPoints),
+			names.AttrName:        aws.StringValue(apiObject.Name),
+			"port_mapping":        flattenContainerDefinitionPortMappings(apiObject.PortMappings),
+			"secret":              flattenContainerDefinitionSecrets(apiObject.Secrets),
+			"working_directory":   aws.StringValue(apiObject.WorkingDirectory),
+		}
+
+		// ECS omits essential when it is the default.
+		if apiObject.Essential == nil {
+			tfMap["essential"] = true
+		}
+
+		if v := apiObject.HealthCheck; v != nil {
+			tfMap[names.AttrHealthCheck] = []interface{}{flattenContainerDefinitionHealthCheck(v)}
+		}
+
+		if v := apiObject.LogConfiguration; v != nil {
+			tfMap["log_configuration"] = []interface{}{flattenContainerDefinitionLogConfiguration(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionDependencies(apiObjects []*ecs.ContainerDependency) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"condition":      aws.StringValue(apiObject.Condition),
+			"container_name": aws.StringValue(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionKeyValuePairs(apiObjects []*ecs.KeyValuePair) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:  aws.StringValue(apiObject.Name),
+			names.AttrValue: aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionHealthCheck(apiObject *ecs.HealthCheck) map[string]interface{} {
+	return map[string]interface{}{
+		"command":          aws.StringValueSlice(apiObject.Command),
+		names.AttrInterval: aws.Int64Value(apiObject.Interval),
+		"retries":          aws.Int64Value(apiObject.Retries),
+		"start_period":     aws.Int64Value(apiObject.StartPeriod),
+		names.AttrTimeout:  aws.Int64Value(apiObject.Timeout),
+	}
+}
+
+func flattenContainerDefinitionLogConfiguration(apiObject *ecs.LogConfiguration) map[string]interface{} {
+	return map[string]interface{}{
+		"log_driver":    aws.StringValue(apiObject.LogDriver),
+		"options":       aws.StringValueMap(apiObject.Options),
+		"secret_option": flattenContainerDefinitionSecrets(apiObject.SecretOptions),
+	}
+}
+
+func flattenContainerDefinitionMountPoints(apiObjects []*ecs.MountPoint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"container_path": aws.StringValue(apiObject.ContainerPath),
+			"read_only":      aws.BoolValue(apiObject.ReadOnly),
+			"source_volume":  aws.StringValue(apiObject.SourceVolume),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionPortMappings(apiObjects []*ecs.PortMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"app_protocol":     aws.StringValue(apiObject.AppProtocol),
+			"container_port":   aws.Int64Value(apiObject.ContainerPort),
+			"host_port":        aws.Int64Value(apiObject.HostPort),
+			names.AttrName:     aws.StringValue(apiObject.Name),
+			names.AttrProtocol: aws.StringValue(apiObject.Protocol),
+		}
+
+		// ECS omits protocol when it is the default.
+		if apiObject.Protocol == nil {
+			tfMap[names.AttrProtocol] = ecs.TransportProtocolTcp
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionSecrets(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: aws.StringValue(apiObject.Name),
+			"value_from":   aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
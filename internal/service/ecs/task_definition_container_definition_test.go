@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestContainerDefinitionBlocksDefaults(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"container_definition": containerDefinitionSchema()}, map[string]interface{}{
+		"container_definition": []interface{}{
+			map[string]interface{}{
+				"name":  "web",
+				"image": "nginx",
+				"port_mapping": []interface{}{
+					map[string]interface{}{"container_port": 80},
+				},
+				"health_check": []interface{}{
+					map[string]interface{}{"command": []interface{}{"CMD-SHELL", "true"}},
+				},
+			},
+		},
+	})
+	got := expandContainerDefinitionBlocks(d.Get("container_definition").([]interface{}))
+	want := []*ecs.ContainerDefinition{
+		{
+			Cpu:       aws.Int64(0),
+			Essential: aws.Bool(true),
+			HealthCheck: &ecs.HealthCheck{
+				Command:     aws.StringSlice([]string{"CMD-SHELL", "true"}),
+				Interval:    aws.Int64(30),
+				Retries:     aws.Int64(3),
+				StartPeriod: aws.Int64(0),
+				Timeout:     aws.Int64(5),
+			},
+			Image: aws.String("nginx"),
+			Name:  aws.String("web"),
+			PortMappings: []*ecs.PortMapping{
+				{
+					ContainerPort: aws.Int64(80),
+					Protocol:      aws.String(ecs.TransportProtocolTcp),
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestContainerDefinitionBlocksRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// What ECS returns when the defaults are omitted on registration.
+	apiObjects := []*ecs.ContainerDefinition{
+		{
+			Cpu:         aws.Int64(0),
+			Environment: []*ecs.KeyValuePair{{Name: aws.String("A"), Value: aws.String("1")}},
+			Image:       aws.String("nginx"),
+			MountPoints: []*ecs.MountPoint{},
+			Name:        aws.String("web"),
+			PortMappings: []*ecs.PortMapping{
+				{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80)},
+			},
+			Secrets: []*ecs.Secret{{Name: aws.String("S"), ValueFrom: aws.String("arn")}},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"container_definition": containerDefinitionSchema()}, map[string]interface{}{})
+	if err := d.Set("container_definition", flattenContainerDefinitionBlocks(apiObjects)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := expandContainerDefinitionBlocks(d.Get("container_definition").([]interface{}))
+	want := []*ecs.ContainerDefinition{
+		{
+			Cpu:         aws.Int64(0),
+			Environment: []*ecs.KeyValuePair{{Name: aws.String("A"), Value: aws.String("1")}},
+			Essential:   aws.Bool(true),
+			Image:       aws.String("nginx"),
+			Name:        aws.String("web"),
+			PortMappings: []*ecs.PortMapping{
+				{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)},
+			},
+			Secrets: []*ecs.Secret{{Name: aws.String("S"), ValueFrom: aws.String("arn")}},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -107,6 +107,56 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.cpu", "0"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.host_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.condition", "HEALTHY"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.health_check.0.timeout", "5"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				Config:   testAccTaskDefinitionConfig_containerDefinitionBlock(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"container_definition", names.AttrSkipDestroy, "track_latest"},
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/2370
 func TestAccECSTaskDefinition_scratchVolume(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1456,6 +1506,60 @@ TASK_DEFINITION
 `, rName))
 }
 
+func testAccTaskDefinitionConfig_containerDefinitionBlock(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  network_mode = "awsvpc"
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    memory = 128
+
+    environment {
+      name  = "B"
+      value = "2"
+    }
+
+    environment {
+      name  = "A"
+      value = "1"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+
+    depends_on {
+      container_name = "sidecar"
+      condition      = "HEALTHY"
+    }
+
+    log_configuration {
+      log_driver = "json-file"
+
+      options = {
+        max-size = "10m"
+      }
+    }
+  }
+
+  container_definition {
+    name      = "sidecar"
+    image     = "busybox:latest"
+    memory    = 64
+    essential = false
+    command   = ["sleep", "3600"]
+
+    health_check {
+      command = ["CMD-SHELL", "true"]
+    }
+  }
+}
+`, rName)
+}
+
 func testAccTaskDefinitionConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigAuthMethodExec  = "exec"
+	kubeconfigAuthMethodToken = "token"
+
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+// @SDKDataSource("aws_eks_cluster_kubeconfig", name="Cluster Kubeconfig")
+func dataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kubeconfigAuthMethodToken,
+				ValidateFunc: validation.StringInSlice([]string{kubeconfigAuthMethodExec, kubeconfigAuthMethodToken}, false),
+			},
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exec_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aws",
+			},
+			"exec_env": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token_expiry": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(presignedURLExpiration / time.Second),
+				ValidateFunc: validation.IntBetween(60, int(presignedURLExpiration/time.Second)),
+			},
+		},
+	}
+}
+
+func dataSourceClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	cluster, err := findClusterByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", name, err)
+	}
+
+	caData := ""
+	if cluster.CertificateAuthority != nil {
+		caData = aws.ToString(cluster.CertificateAuthority.Data)
+	}
+
+	caCertificate, err := base64.StdEncoding.DecodeString(caData)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decoding EKS Cluster (%s) certificate authority: %s", name, err)
+	}
+
+	// Tokens for local clusters on Outposts are for the cluster ID rather than the name.
+	clusterID := name
+	if cluster.OutpostConfig != nil {
+		clusterID = aws.ToString(cluster.Id)
+	}
+
+	stsConn := meta.(*conns.AWSClient).STSClient(ctx)
+	roleARN := d.Get(names.AttrRoleARN).(string)
+	if roleARN != "" {
+		stsConn = sts.New(stsConn.Options(), func(o *sts.Options) {
+			o.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsConn, roleARN))
+		})
+	}
+
+	generator, err := NewGenerator(false, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	token, err := generator.GetWithSTSExpiry(ctx, clusterID, time.Duration(d.Get("token_expiry").(int))*time.Second, stsConn)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) Authentication Token: %s", name, err)
+	}
+
+	config := &kubeconfigInput{
+		caData:    caData,
+		clusterID: clusterID,
+		contextID: aws.ToString(cluster.Arn),
+		endpoint:  aws.ToString(cluster.Endpoint),
+		outposts:  cluster.OutpostConfig != nil,
+		region:    meta.(*conns.AWSClient).Region,
+		roleARN:   roleARN,
+	}
+
+	switch d.Get("auth_method").(string) {
+	case kubeconfigAuthMethodExec:
+		config.execCommand = d.Get("exec_command").(string)
+		if v, ok := d.GetOk("exec_env"); ok {
+			config.execEnv = flex.ExpandStringValueMap(v.(map[string]interface{}))
+		}
+	default:
+		config.token = token.Token
+	}
+
+	kubeconfig, err := config.build()
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building EKS Cluster (%s) kubeconfig: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("cluster_ca_certificate", string(caCertificate))
+	d.Set("host", cluster.Endpoint)
+	d.Set("kubeconfig", string(kubeconfig))
+	d.Set("token", token.Token)
+	d.Set("token_expiration", token.Expiration.UTC().Format(time.RFC3339))
+
+	return diags
+}
+
+// kubeconfigInput contains the values used to build a kubeconfig for a cluster.
+// If token is set the kubeconfig embeds it, otherwise the kubeconfig runs the AWS CLI's "eks get-token" command.
+type kubeconfigInput struct {
+	caData      string
+	clusterID   string
+	contextID   string
+	endpoint    string
+	execCommand string
+	execEnv     map[string]string
+	outposts    bool
+	region      string
+	roleARN     string
+	token       string
+}
+
+type kubeconfig struct {
+	APIVersion     string              `yaml:"apiVersion"`
+	Kind           string              `yaml:"kind"`
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Contexts       []kubeconfigContext `yaml:"contexts"`
+	CurrentContext string              `yaml:"current-context"`
+	Preferences    struct{}            `yaml:"preferences"`
+	Users          []kubeconfigUser    `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		Server                   string `yaml:"server"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Exec  *kubeconfigExec `yaml:"exec,omitempty"`
+		Token string          `yaml:"token,omitempty"`
+	} `yaml:"user"`
+}
+
+type kubeconfigExec struct {
+	APIVersion         string              `yaml:"apiVersion"`
+	Args               []string            `yaml:"args"`
+	Command            string              `yaml:"command"`
+	Env                []kubeconfigExecEnv `yaml:"env,omitempty"`
+	InteractiveMode    string              `yaml:"interactiveMode"`
+	ProvideClusterInfo bool                `yaml:"provideClusterInfo"`
+}
+
+type kubeconfigExecEnv struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+func (in *kubeconfigInput) build() ([]byte, error) {
+	out := kubeconfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		CurrentContext: in.contextID,
+	}
+
+	var cluster kubeconfigCluster
+	cluster.Name = in.contextID
+	cluster.Cluster.CertificateAuthorityData = in.caData
+	cluster.Cluster.Server = in.endpoint
+	out.Clusters = append(out.Clusters, cluster)
+
+	var kubeContext kubeconfigContext
+	kubeContext.Name = in.contextID
+	kubeContext.Context.Cluster = in.contextID
+	kubeContext.Context.User = in.contextID
+	out.Contexts = append(out.Contexts, kubeContext)
+
+	var user kubeconfigUser
+	user.Name = in.contextID
+
+	if in.token != "" {
+		user.User.Token = in.token
+	} else {
+		exec := &kubeconfigExec{
+			APIVersion:      kubeconfigExecAPIVersion,
+			Args:            []string{"--region", in.region, "eks", "get-token"},
+			Command:         in.execCommand,
+			InteractiveMode: "IfAvailable",
+		}
+
+		if in.outposts {
+			exec.Args = append(exec.Args, "--cluster-id", in.clusterID)
+		} else {
+			exec.Args = append(exec.Args, "--cluster-name", in.clusterID)
+		}
+
+		exec.Args = append(exec.Args, "--output", "json")
+
+		if in.roleARN != "" {
+			exec.Args = append(exec.Args, "--role-arn", in.roleARN)
+		}
+
+		keys := tfmaps.Keys(in.execEnv)
+		slices.Sort(keys)
+		for _, k := range keys {
+			exec.Env = append(exec.Env, kubeconfigExecEnv{Name: k, Value: in.execEnv[k]})
+		}
+
+		user.User.Exec = exec
+	}
+
+	out.Users = append(out.Users, user)
+
+	return yaml.Marshal(out)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_basic(rName, "token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "host", resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "kubeconfig"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token_expiration"),
+					testAccCheckClusterAuthToken(dataSourceResourceName),
+				),
+			},
+			{
+				Config: testAccClusterKubeconfigDataSourceConfig_basic(rName, "exec"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "host", resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "kubeconfig"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterKubeconfigDataSourceConfig_basic(rName, authMethod string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+data "aws_eks_cluster_kubeconfig" "test" {
+  name        = aws_eks_cluster.test.name
+  auth_method = %[1]q
+}
+`, authMethod))
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v2"
+)
+
+func TestKubeconfigInputBuild(t *testing.T) {
+	t.Parallel()
+
+	const contextID = "arn:aws:eks:us-west-2:123456789012:cluster/example" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		input    kubeconfigInput
+		wantUser kubeconfigUser
+	}{
+		"token": {
+			input: kubeconfigInput{
+				clusterID: "example",
+				contextID: contextID,
+				region:    "us-west-2", //lintignore:AWSAT003
+				token:     "k8s-aws-v1.abc",
+			},
+			wantUser: func() kubeconfigUser {
+				var u kubeconfigUser
+				u.Name = contextID
+				u.User.Token = "k8s-aws-v1.abc"
+				return u
+			}(),
+		},
+		"exec": {
+			input: kubeconfigInput{
+				clusterID:   "example",
+				contextID:   contextID,
+				execCommand: "aws",
+				execEnv:     map[string]string{"B": "2", "A": "1"},
+				region:      "us-west-2",                              //lintignore:AWSAT003
+				roleARN:     "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			},
+			wantUser: func() kubeconfigUser {
+				var u kubeconfigUser
+				u.Name = contextID
+				u.User.Exec = &kubeconfigExec{
+					APIVersion:      kubeconfigExecAPIVersion,
+					Args:            []string{"--region", "us-west-2", "eks", "get-token", "--cluster-name", "example", "--output", "json", "--role-arn", "arn:aws:iam::123456789012:role/example"}, //lintignore:AWSAT003,AWSAT005
+					Command:         "aws",
+					Env:             []kubeconfigExecEnv{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}},
+					InteractiveMode: "IfAvailable",
+				}
+				return u
+			}(),
+		},
+		"exec outposts": {
+			input: kubeconfigInput{
+				clusterID:   "f1e2d3c4-b5a6-7890-1234-567890abcdef",
+				contextID:   contextID,
+				execCommand: "aws",
+				outposts:    true,
+				region:      "us-west-2", //lintignore:AWSAT003
+			},
+			wantUser: func() kubeconfigUser {
+				var u kubeconfigUser
+				u.Name = contextID
+				u.User.Exec = &kubeconfigExec{
+					APIVersion:      kubeconfigExecAPIVersion,
+					Args:            []string{"--region", "us-west-2", "eks", "get-token", "--cluster-id", "f1e2d3c4-b5a6-7890-1234-567890abcdef", "--output", "json"}, //lintignore:AWSAT003
+					Command:         "aws",
+					InteractiveMode: "IfAvailable",
+				}
+				return u
+			}(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.input.caData = "Q0E="
+			testCase.input.endpoint = "https://example.eks.amazonaws.com"
+
+			b, err := testCase.input.build()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got kubeconfig
+			if err := yaml.Unmarshal(b, &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := got.CurrentContext, contextID; got != want {
+				t.Errorf("current-context = %q, want %q", got, want)
+			}
+			if len(got.Clusters) != 1 || got.Clusters[0].Cluster.Server != "https://example.eks.amazonaws.com" || got.Clusters[0].Cluster.CertificateAuthorityData != "Q0E=" {
+				t.Errorf("unexpected clusters: %+v", got.Clusters)
+			}
+			if len(got.Contexts) != 1 || got.Contexts[0].Context.Cluster != contextID || got.Contexts[0].Context.User != contextID {
+				t.Errorf("unexpected contexts: %+v", got.Contexts)
+			}
+			if len(got.Users) != 1 {
+				t.Fatalf("got %d users, want 1", len(got.Users))
+			}
+			if diff := cmp.Diff(got.Users[0], testCase.wantUser); diff != "" {
+				t.Errorf("unexpected user diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
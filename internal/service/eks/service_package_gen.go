@@ -54,6 +54,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceClusterAuth,
 			TypeName: "aws_eks_cluster_auth",
 		},
+		{
+			Factory:  dataSourceClusterKubeconfig,
+			TypeName: "aws_eks_cluster_kubeconfig",
+			Name:     "Cluster Kubeconfig",
+		},
 		{
 			Factory:  dataSourceClusters,
 			TypeName: "aws_eks_clusters",
@@ -13,6 +13,7 @@ With the following modifications:
  - Refactor deprecated io/ioutil in Go 1.16
  - Adds context parameter
  - Updated to use the AWS SDK for Go v2
+ - Adds token expiration and GetWithSTSExpiry to set the X-Amz-Expires parameter
 */
 
 /*
@@ -104,7 +105,8 @@ const (
 
 // Token is generated and used by Kubernetes client-go to authenticate with a Kubernetes cluster.
 type Token struct {
-	Token string
+	Token      string
+	Expiration time.Time
 }
 
 // FormatError is returned when there is a problem with token that is
@@ -163,6 +165,9 @@ type getCallerIdentityWrapper struct {
 type Generator interface {
 	// GetWithSTS returns a token valid for clusterID using the given STS client.
 	GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts.Client) (Token, error)
+	// GetWithSTSExpiry returns a token valid for clusterID using the given STS client,
+	// with the X-Amz-Expires parameter of the pre-signed URL set to expiry.
+	GetWithSTSExpiry(ctx context.Context, clusterID string, expiry time.Duration, stsAPI *sts.Client) (Token, error)
 }
 
 type generator struct {
@@ -184,23 +189,37 @@ func (g generator) GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts
 	// not supported by the STS presigner.  We set it to 60 [nano]seconds for backwards compatibility (the
 	// parameter was a required argument to AWS SDK v1's Presign(), and authenticators 0.3.0 and older are
 	// expecting a value between 0 and 60 on the server side).
+	return g.GetWithSTSExpiry(ctx, clusterID, requestPresignParam, stsAPI)
+}
+
+// GetWithSTSExpiry returns a token valid for clusterID using the given STS client,
+// with the X-Amz-Expires parameter of the pre-signed URL set to expiry.
+// The token expires when the pre-signed URL does, at most 15 minutes after it is signed.
+func (g generator) GetWithSTSExpiry(ctx context.Context, clusterID string, expiry time.Duration, stsAPI *sts.Client) (Token, error) {
 	presigner := sts.NewPresignClient(stsAPI, func(po *sts.PresignOptions) {
 		po.ClientOptions = []func(*sts.Options){
 			func(o *sts.Options) {
 				o.APIOptions = []func(*middleware.Stack) error{
 					addClusterIdHeaderSetterMiddleware(clusterID),
-					addExpiryParamSetterMiddleware(requestPresignParam),
+					addExpiryParamSetterMiddleware(expiry),
 				}
 			},
 		}
 	})
 
+	// Set token expiration to 1 minute before the presigned URL expires for some cushion,
+	// or to the requested expiry if that is sooner.
+	tokenExpiration := time.Now().Add(presignedURLExpiration - 1*time.Minute)
+	if expiry >= time.Second && expiry < presignedURLExpiration-1*time.Minute {
+		tokenExpiration = time.Now().Add(expiry)
+	}
+
 	request, err := presigner.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return Token{}, err
 	}
 
-	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL))}, nil
+	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL)), tokenExpiration}, nil
 }
 
 func addClusterIdHeaderSetterMiddleware(clusterID string) func(*middleware.Stack) error {
@@ -3,6 +3,10 @@
 
 package iam
 
+import (
+	"fmt"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceAccessKey = resourceAccessKey
@@ -51,3 +55,18 @@ var (
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
 )
+
+// AnalyzePolicy returns the findings of analyzePolicy formatted as "<statement index> <severity> <code>".
+func AnalyzePolicy(policy string, sensitiveServices []string) ([]string, error) {
+	findings, err := analyzePolicy(policy, sensitiveServices)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for _, finding := range findings {
+		out = append(out, fmt.Sprintf("%d %s %s", finding.statementIndex, finding.severity, finding.code))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	policyFindingSeverityHigh   = "HIGH"
+	policyFindingSeverityMedium = "MEDIUM"
+	policyFindingSeverityLow    = "LOW"
+)
+
+const (
+	policyFindingCodeAllowNotAction          = "ALLOW_NOT_ACTION"
+	policyFindingCodeAlwaysMatchingCondition = "ALWAYS_MATCHING_CONDITION"
+	policyFindingCodeAllowNotResource        = "ALLOW_NOT_RESOURCE"
+	policyFindingCodeInvalidPrincipal        = "INVALID_PRINCIPAL"
+	policyFindingCodeMalformedARN            = "MALFORMED_ARN"
+	policyFindingCodeMalformedCondition      = "MALFORMED_CONDITION_VALUE"
+	policyFindingCodePublicPrincipal         = "PUBLIC_PRINCIPAL"
+	policyFindingCodeUnsatisfiableCondition  = "UNSATISFIABLE_CONDITION"
+	policyFindingCodeWildcardAction          = "WILDCARD_ACTION"
+	policyFindingCodeWildcardSensitiveAction = "WILDCARD_SENSITIVE_ACTION"
+)
+
+// policyAnalysisDefaultSensitiveServices are the services whose actions can be used to escalate privileges or to read or destroy data.
+var policyAnalysisDefaultSensitiveServices = []string{
+	"iam",
+	"kms",
+	"organizations",
+	"s3",
+	"secretsmanager",
+	"ssm",
+	"sts",
+}
+
+// @SDKDataSource("aws_iam_policy_analysis", name="Policy Analysis")
+func dataSourcePolicyAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			names.AttrPolicy: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `The policy document to analyze, in JSON format.`,
+			},
+			"sensitive_services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `Service prefixes, like "iam", whose wildcard actions are reported with HIGH severity. Defaults to iam, kms, organizations, s3, secretsmanager, ssm and sts.`,
+			},
+
+			// Result Attributes
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The kind of finding, like "WILDCARD_ACTION".`,
+						},
+						names.AttrMessage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A description of the finding.`,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The severity of the finding: "HIGH", "MEDIUM" or "LOW".`,
+						},
+						"sid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Sid of the statement, if any.`,
+						},
+						"statement_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The zero-based index of the statement in the policy.`,
+						},
+					},
+				},
+				Description: `The findings, in the order of the statements in the policy.`,
+			},
+			"highest_severity": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The highest severity of all findings, or an empty string if there are no findings.`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy := d.Get(names.AttrPolicy).(string)
+	sensitiveServices := policyAnalysisDefaultSensitiveServices
+	if v, ok := d.GetOk("sensitive_services"); ok && v.(*schema.Set).Len() > 0 {
+		sensitiveServices = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	findings, err := analyzePolicy(policy, sensitiveServices)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "analyzing IAM Policy: %s", err)
+	}
+
+	var highestSeverity string
+	tfList := make([]interface{}, 0, len(findings))
+	for _, finding := range findings {
+		if policyFindingSeverityRank(finding.severity) > policyFindingSeverityRank(highestSeverity) {
+			highestSeverity = finding.severity
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"code":            finding.code,
+			names.AttrMessage: finding.message,
+			"severity":        finding.severity,
+			"sid":             finding.sid,
+			"statement_index": finding.statementIndex,
+		})
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policy)))
+	d.Set("findings", tfList)
+	d.Set("highest_severity", highestSeverity)
+
+	return diags
+}
+
+type policyFinding struct {
+	code           string
+	message        string
+	severity       string
+	sid            string
+	statementIndex int
+}
+
+func policyFindingSeverityRank(severity string) int {
+	switch severity {
+	case policyFindingSeverityHigh:
+		return 3
+	case policyFindingSeverityMedium:
+		return 2
+	case policyFindingSeverityLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// analyzePolicy reports the risky and broken statements in a policy document without calling AWS.
+func analyzePolicy(policy string, sensitiveServices []string) ([]policyFinding, error) {
+	doc, err := unmarshalPolicyAnalysisDoc(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	sensitiveServices = tfslices.ApplyToAll(sensitiveServices, strings.ToLower)
+
+	var findings []policyFinding
+
+	for i, stmt := range doc.Statements {
+		add := func(code, severity, format string, a ...interface{}) {
+			findings = append(findings, policyFinding{
+				code:           code,
+				message:        fmt.Sprintf(format, a...),
+				severity:       severity,
+				sid:            stmt.Sid,
+				statementIndex: i,
+			})
+		}
+		allow := stmt.Effect == "Allow"
+
+		if allow {
+			for _, action := range policyStringList(stmt.Actions) {
+				if action == "*" {
+					add(policyFindingCodeWildcardSensitiveAction, policyFindingSeverityHigh, "Allows all actions of all services")
+					continue
+				}
+
+				service, name, _ := strings.Cut(strings.ToLower(action), ":")
+				if !strings.Contains(name, "*") {
+					continue
+				}
+
+				if slices.Contains(sensitiveServices, service) {
+					if name == "*" {
+						add(policyFindingCodeWildcardSensitiveAction, policyFindingSeverityHigh, "Allows all actions of sensitive service %q", service)
+					} else {
+						add(policyFindingCodeWildcardSensitiveAction, policyFindingSeverityMedium, "Allows wildcard action %q of sensitive service %q", action, service)
+					}
+				} else if name == "*" {
+					add(policyFindingCodeWildcardAction, policyFindingSeverityLow, "Allows all actions of service %q", service)
+				}
+			}
+
+			if stmt.NotActions != nil {
+				add(policyFindingCodeAllowNotAction, policyFindingSeverityMedium, "Allows all actions except those in NotAction, including actions added to AWS in the future")
+			}
+
+			if stmt.NotResources != nil {
+				add(policyFindingCodeAllowNotResource, policyFindingSeverityMedium, "Allows access to all resources except those in NotResource")
+			}
+
+			if policyPrincipalsArePublic(stmt.Principals) {
+				if len(stmt.Conditions) == 0 {
+					add(policyFindingCodePublicPrincipal, policyFindingSeverityHigh, "Allows access to anyone")
+				} else {
+					add(policyFindingCodePublicPrincipal, policyFindingSeverityMedium, "Allows access to anyone that satisfies the conditions")
+				}
+			}
+		}
+
+		if len(stmt.Principals) > 0 || len(stmt.NotPrincipals) > 0 {
+			stmtPolicy, err := json.Marshal(&IAMPolicyDoc{Statements: []*IAMPolicyStatement{stmt}})
+			if err != nil {
+				return nil, err
+			}
+
+			if valid, err := PolicyHasValidAWSPrincipals(string(stmtPolicy)); err != nil {
+				return nil, err
+			} else if !valid {
+				add(policyFindingCodeInvalidPrincipal, policyFindingSeverityMedium, "AWS principals must be an ARN, an account ID or \"*\"")
+			}
+		}
+
+		for _, resource := range append(policyStringList(stmt.Resources), policyStringList(stmt.NotResources)...) {
+			if !policyResourceIsValid(resource) {
+				add(policyFindingCodeMalformedARN, policyFindingSeverityMedium, "Resource %q is not a valid ARN", resource)
+			}
+		}
+
+		for _, condition := range stmt.Conditions {
+			invalidValues, reason := policyConditionInvalidValues(condition)
+
+			// An invalid value never matches, so a negated operator, e.g. NotIpAddress, with only invalid values always matches.
+			if policyConditionOperatorIsNegated(condition.Test) {
+				if reason != "" {
+					// An Allow statement that always applies doesn't restrict access as intended.
+					severity := policyFindingSeverityLow
+					if allow {
+						severity = policyFindingSeverityHigh
+					}
+
+					add(policyFindingCodeAlwaysMatchingCondition, severity, "Condition %s on %q always matches: %s", condition.Test, condition.Variable, reason)
+					continue
+				}
+
+				for _, v := range invalidValues {
+					add(policyFindingCodeMalformedCondition, policyFindingSeverityMedium, "Condition %s on %q has value %q, which has no effect", condition.Test, condition.Variable, v)
+				}
+
+				continue
+			}
+
+			if reason != "" {
+				// A Deny statement that can never apply is a silent gap in the policy.
+				severity := policyFindingSeverityLow
+				if !allow {
+					severity = policyFindingSeverityHigh
+				}
+
+				add(policyFindingCodeUnsatisfiableCondition, severity, "Condition %s on %q can never match: %s", condition.Test, condition.Variable, reason)
+				continue
+			}
+
+			for _, v := range invalidValues {
+				add(policyFindingCodeMalformedCondition, policyFindingSeverityMedium, "Condition %s on %q has value %q, which can never match", condition.Test, condition.Variable, v)
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+// unmarshalPolicyAnalysisDoc decodes a policy document, accepting a single statement object as well as an array of statements.
+func unmarshalPolicyAnalysisDoc(policy string) (*IAMPolicyDoc, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	if v, ok := raw["Statement"]; ok && strings.HasPrefix(strings.TrimSpace(string(v)), "{") {
+		raw["Statement"] = json.RawMessage("[" + string(v) + "]")
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	return doc, nil
+}
+
+func policyPrincipalsArePublic(principals IAMPolicyStatementPrincipalSet) bool {
+	for _, principal := range principals {
+		if principal.Type != "*" && principal.Type != "AWS" {
+			continue
+		}
+
+		if slices.Contains(policyStringList(principal.Identifiers), "*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyResourceIsValid(resource string) bool {
+	if resource == "*" {
+		return true
+	}
+
+	// Policy variables can't be checked until they are resolved.
+	if strings.Contains(resource, "${") {
+		return strings.HasPrefix(resource, "arn:")
+	}
+
+	v, err := arn.Parse(resource)
+	if err != nil {
+		return false
+	}
+
+	return v.Partition != "" && v.Service != "" && v.Resource != ""
+}
+
+// policyConditionOperator returns the condition operator without any set operator prefix or IfExists suffix.
+func policyConditionOperator(test string) string {
+	operator := test
+	if _, v, ok := strings.Cut(operator, ":"); ok {
+		operator = v // ForAllValues: or ForAnyValue:
+	}
+
+	return strings.TrimSuffix(operator, "IfExists")
+}
+
+// policyConditionOperatorIsNegated returns whether the condition operator is negated, e.g. NumericNotEquals or NotIpAddress.
+// A negated operator matches if the condition key matches none of the values.
+func policyConditionOperatorIsNegated(test string) bool {
+	return strings.Contains(policyConditionOperator(test), "Not")
+}
+
+// policyConditionInvalidValues returns the condition's values that are not valid for its operator.
+// Multiple values for a condition key are ORed, so the condition's operator can only never match if it has no values or
+// all of its values are invalid. In that case the reason is returned too.
+func policyConditionInvalidValues(condition IAMPolicyStatementCondition) ([]string, string) {
+	values := policyStringList(condition.Values)
+
+	if len(values) == 0 {
+		return nil, "no values"
+	}
+
+	operator := policyConditionOperator(condition.Test)
+
+	var valid func(string) bool
+
+	switch {
+	case operator == "Bool" || operator == "Null":
+		valid = func(v string) bool {
+			return strings.EqualFold(v, "true") || strings.EqualFold(v, "false")
+		}
+	case strings.HasPrefix(operator, "Numeric"):
+		valid = func(v string) bool {
+			_, err := strconv.ParseFloat(v, 64)
+			return err == nil
+		}
+	case strings.HasPrefix(operator, "Date"):
+		valid = func(v string) bool {
+			if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return true
+			}
+			for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02"} {
+				if _, err := time.Parse(layout, v); err == nil {
+					return true
+				}
+			}
+			return false
+		}
+	case operator == "IpAddress" || operator == "NotIpAddress":
+		valid = func(v string) bool {
+			if _, _, err := net.ParseCIDR(v); err == nil {
+				return true
+			}
+			return net.ParseIP(v) != nil
+		}
+	case strings.HasPrefix(operator, "Arn"):
+		valid = func(v string) bool {
+			return v == "*" || strings.HasPrefix(v, "arn:")
+		}
+	default:
+		return nil, ""
+	}
+
+	var invalidValues []string
+	for _, v := range values {
+		// Policy variables can't be checked until they are resolved.
+		if strings.Contains(v, "${") {
+			continue
+		}
+
+		if !valid(v) {
+			invalidValues = append(invalidValues, v)
+		}
+	}
+
+	if len(invalidValues) == len(values) {
+		if len(values) == 1 {
+			return nil, fmt.Sprintf("%q is not a valid value for %s", values[0], operator)
+		}
+
+		return nil, fmt.Sprintf("none of the values is valid for %s", operator)
+	}
+
+	return invalidValues, ""
+}
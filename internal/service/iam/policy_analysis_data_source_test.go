@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAnalyzePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy            string
+		sensitiveServices []string
+		expected          []string
+		expectError       bool
+	}{
+		"least privilege": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:ListBucket"],
+    "Resource": ["arn:aws:s3:::bucket", "arn:aws:s3:::bucket/${aws:username}/*"],
+    "Condition": {"Bool": {"aws:SecureTransport": "true"}}
+  }]
+}`,
+		},
+		"wildcard actions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "*", "Resource": "*"},
+    {"Effect": "Allow", "Action": ["iam:*", "kms:Decrypt*", "ec2:*", "ec2:Describe*"], "Resource": "*"},
+    {"Effect": "Deny", "Action": "*", "Resource": "*"}
+  ]
+}`,
+			expected: []string{
+				"0 HIGH WILDCARD_SENSITIVE_ACTION",
+				"1 HIGH WILDCARD_SENSITIVE_ACTION",
+				"1 MEDIUM WILDCARD_SENSITIVE_ACTION",
+				"1 LOW WILDCARD_ACTION",
+			},
+		},
+		"custom sensitive services": {
+			policy:            `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": ["ec2:*", "iam:*"], "Resource": "*"}}`,
+			sensitiveServices: []string{"ec2"},
+			expected: []string{
+				"0 HIGH WILDCARD_SENSITIVE_ACTION",
+				"0 LOW WILDCARD_ACTION",
+			},
+		},
+		"sensitive services are case-insensitive": {
+			policy:            `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "EC2:*", "Resource": "*"}}`,
+			sensitiveServices: []string{"EC2"},
+			expected: []string{
+				"0 HIGH WILDCARD_SENSITIVE_ACTION",
+			},
+		},
+		"not action and not resource": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "NotAction": "iam:*", "NotResource": "arn:aws:s3:::bucket"},
+    {"Effect": "Deny", "NotAction": "s3:GetObject", "NotResource": "arn:aws:s3:::bucket"}
+  ]
+}`,
+			expected: []string{
+				"0 MEDIUM ALLOW_NOT_ACTION",
+				"0 MEDIUM ALLOW_NOT_RESOURCE",
+			},
+		},
+		"principals": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Public", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"},
+    {"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*", "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-1234567890"}}},
+    {"Effect": "Allow", "Principal": {"AWS": "AROAEXAMPLEID"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"},
+    {"Effect": "Allow", "Principal": {"Service": "logs.amazonaws.com"}, "Action": "s3:PutObject", "Resource": "arn:aws:s3:::bucket/*"}
+  ]
+}`,
+			expected: []string{
+				"0 HIGH PUBLIC_PRINCIPAL",
+				"1 MEDIUM PUBLIC_PRINCIPAL",
+				"2 MEDIUM INVALID_PRINCIPAL",
+			},
+		},
+		"malformed ARNs": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "s3:GetObject",
+    "Resource": ["bucket/*", "arn:aws:s3", "arn:aws:s3:::bucket/*"]
+  }]
+}`,
+			expected: []string{
+				"0 MEDIUM MALFORMED_ARN",
+				"0 MEDIUM MALFORMED_ARN",
+			},
+		},
+		"unsatisfiable conditions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*",
+      "Condition": {
+        "Bool": {"aws:SecureTransport": "yes"},
+        "NumericLessThanIfExists": {"s3:max-keys": "ten"},
+        "DateGreaterThan": {"aws:CurrentTime": "2024-01-01T00:00:00Z"}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "*",
+      "Condition": {
+        "NotIpAddress": {"aws:SourceIp": ["10.0.0.0/8", "192.168.0.0/33"]},
+        "ForAnyValue:ArnLike": {"aws:PrincipalArn": "role/admin"}
+      }
+    }
+  ]
+}`,
+			expected: []string{
+				"0 LOW UNSATISFIABLE_CONDITION",
+				"0 LOW UNSATISFIABLE_CONDITION",
+				"1 MEDIUM MALFORMED_CONDITION_VALUE",
+				"1 HIGH UNSATISFIABLE_CONDITION",
+			},
+		},
+		"partially invalid condition values": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "*",
+      "Condition": {
+        "Bool": {"aws:SecureTransport": ["false", "no"]},
+        "NumericGreaterThan": {"s3:max-keys": ["ten", "twenty"]},
+        "StringEquals": {"aws:PrincipalTag/team": []}
+      }
+    }
+  ]
+}`,
+			expected: []string{
+				"0 MEDIUM MALFORMED_CONDITION_VALUE",
+				"0 HIGH UNSATISFIABLE_CONDITION",
+				"0 HIGH UNSATISFIABLE_CONDITION",
+			},
+		},
+		"negated conditions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*",
+      "Condition": {
+        "NotIpAddress": {"aws:SourceIp": "10.0.0.0/33"},
+        "ArnNotLike": {"aws:PrincipalArn": ["role/admin", "arn:aws:iam::*:role/admin"]}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "*",
+      "Condition": {
+        "ArnNotLike": {"aws:PrincipalArn": "role/admin"},
+        "NumericNotEquals": {"s3:max-keys": "10"}
+      }
+    }
+  ]
+}`,
+			expected: []string{
+				"0 HIGH ALWAYS_MATCHING_CONDITION",
+				"0 MEDIUM MALFORMED_CONDITION_VALUE",
+				"1 LOW ALWAYS_MATCHING_CONDITION",
+			},
+		},
+		"invalid JSON": {
+			policy:      `{"Statement": [`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sensitiveServices := testCase.sensitiveServices
+			if sensitiveServices == nil {
+				sensitiveServices = []string{"iam", "kms", "s3"}
+			}
+
+			got, err := tfiam.AnalyzePolicy(testCase.policy, sensitiveServices)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// Conditions are unordered, so findings are compared as sets.
+			if diff := cmp.Diff(testCase.expected, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccIAMPolicyAnalysisDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAnalysisDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.code", "WILDCARD_SENSITIVE_ACTION"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.severity", "HIGH"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.sid", "Admin"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.statement_index", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.code", "ALLOW_NOT_RESOURCE"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.severity", "MEDIUM"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.statement_index", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "highest_severity", "HIGH"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyAnalysisDataSourceConfig_basic = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "Admin"
+    actions   = ["iam:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions       = ["ec2:DescribeInstances"]
+    not_resources = ["arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0"]
+  }
+}
+
+data "aws_iam_policy_analysis" "test" {
+  policy = data.aws_iam_policy_document.test.json
+}
+`
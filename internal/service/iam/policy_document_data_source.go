@@ -27,144 +27,163 @@ func dataSourcePolicyDocument() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
 
-		SchemaFunc: func() map[string]*schema.Schema {
-			principalsSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeSet,
-					Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"identifiers": {
-								Type:     schema.TypeSet,
-								Required: true,
-								Elem: &schema.Schema{
-									Type: schema.TypeString,
-								},
-							},
-							names.AttrType: {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-						},
-					},
-				}
-			}
-			setOfStringSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeSet,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
-					},
-				}
-			}
+		SchemaFunc: PolicyDocumentSchema,
+	}
+}
 
-			return map[string]*schema.Schema{
-				names.AttrJSON: {
-					Type:     schema.TypeString,
-					Computed: true,
-				},
-				"minified_json": {
-					Type:     schema.TypeString,
-					Computed: true,
-				},
-				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
-				"override_json": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: validation.StringIsEmpty,
-					Deprecated:   "Not used",
-				},
-				"override_policy_documents": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type:         schema.TypeString,
-						ValidateFunc: validation.StringIsJSON,
+// PolicyDocumentSchema returns the schema of the aws_iam_policy_document data source.
+func PolicyDocumentSchema() map[string]*schema.Schema {
+	principalsSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"identifiers": {
+						Type:     schema.TypeSet,
+						Required: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
 					},
-				},
-				"policy_id": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
-				"source_json": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: validation.StringIsEmpty,
-					Deprecated:   "Not used",
-				},
-				"source_policy_documents": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type:         schema.TypeString,
-						ValidateFunc: validation.StringIsJSON,
+					names.AttrType: {
+						Type:     schema.TypeString,
+						Required: true,
 					},
 				},
-				"statement": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrActions: setOfStringSchema(),
-							names.AttrCondition: {
-								Type:     schema.TypeSet,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"test": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-										names.AttrValues: {
-											Type:     schema.TypeList,
-											Required: true,
-											Elem: &schema.Schema{
-												Type: schema.TypeString,
-											},
-										},
-										"variable": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
+			},
+		}
+	}
+	setOfStringSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+
+	return map[string]*schema.Schema{
+		names.AttrJSON: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"minified_json": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
+		"override_json": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsEmpty,
+			Deprecated:   "Not used",
+		},
+		"override_policy_documents": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+		"policy_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
+		"source_json": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsEmpty,
+			Deprecated:   "Not used",
+		},
+		"source_policy_documents": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+		"statement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrActions: setOfStringSchema(),
+					names.AttrCondition: {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"test": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								names.AttrValues: {
+									Type:     schema.TypeList,
+									Required: true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
 									},
 								},
-							},
-							"effect": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								Default:      "Allow",
-								ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
-							},
-							"not_actions":       setOfStringSchema(),
-							"not_principals":    principalsSchema(),
-							"not_resources":     setOfStringSchema(),
-							"principals":        principalsSchema(),
-							names.AttrResources: setOfStringSchema(),
-							"sid": {
-								Type:     schema.TypeString,
-								Optional: true,
+								"variable": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
 							},
 						},
 					},
+					"effect": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "Allow",
+						ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+					},
+					"not_actions":       setOfStringSchema(),
+					"not_principals":    principalsSchema(),
+					"not_resources":     setOfStringSchema(),
+					"principals":        principalsSchema(),
+					names.AttrResources: setOfStringSchema(),
+					"sid": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
 				},
-				names.AttrVersion: {
-					Type:     schema.TypeString,
-					Optional: true,
-					Default:  "2012-10-17",
-					ValidateFunc: validation.StringInSlice([]string{
-						"2008-10-17",
-						"2012-10-17",
-					}, false),
-				},
-			}
+			},
+		},
+		names.AttrVersion: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "2012-10-17",
+			ValidateFunc: validation.StringInSlice([]string{
+				"2008-10-17",
+				"2012-10-17",
+			}, false),
 		},
 	}
 }
 
 func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+
+	doc, err := ExpandPolicyDocument(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: %s", err)
+	}
+
+	if err := SetPolicyDocument(d, doc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: %s", err)
+	}
+
+	return diags
+}
+
+// ExpandPolicyDocument returns the policy document configured by the arguments in PolicyDocumentSchema.
+// Source documents are merged in order, followed by the configured statements and then override documents.
+func ExpandPolicyDocument(d *schema.ResourceData) (*IAMPolicyDoc, error) {
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
@@ -184,14 +203,14 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 			sourceDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
+				return nil, fmt.Errorf("merging source document %d: %w", sourceJSONIndex, err)
 			}
 
 			// assure all statements in sourceDoc are unique before merging
 			for stmtIndex, stmt := range sourceDoc.Statements {
 				if stmt.Sid != "" {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
+						return nil, fmt.Errorf("merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
 					}
 					sidMap[stmt.Sid] = struct{}{}
 				}
@@ -223,7 +242,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 			if sid, ok := cfgStmt["sid"]; ok {
 				if _, ok := sidMap[sid.(string)]; ok {
-					return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
+					return nil, fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
 				}
 				stmt.Sid = sid.(string)
 				if len(stmt.Sid) > 0 {
@@ -244,7 +263,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 					policyDecodeConfigStringList(resources), doc.Version,
 				)
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading resources: %s", err)
+					return nil, fmt.Errorf("reading resources: %w", err)
 				}
 			}
 			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
@@ -253,7 +272,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 					policyDecodeConfigStringList(notResources), doc.Version,
 				)
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading not_resources: %s", err)
+					return nil, fmt.Errorf("reading not_resources: %w", err)
 				}
 			}
 
@@ -261,7 +280,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				var err error
 				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, doc.Version)
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading principals: %s", err)
+					return nil, fmt.Errorf("reading principals: %w", err)
 				}
 			}
 
@@ -269,7 +288,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				var err error
 				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, doc.Version)
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading not_principals: %s", err)
+					return nil, fmt.Errorf("reading not_principals: %w", err)
 				}
 			}
 
@@ -277,7 +296,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				var err error
 				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, doc.Version)
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading condition: %s", err)
+					return nil, fmt.Errorf("reading condition: %w", err)
 				}
 			}
 
@@ -298,26 +317,31 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 			}
 			overrideDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
+				return nil, fmt.Errorf("merging override document %d: %w", overrideJSONIndex, err)
 			}
 
 			mergedDoc.Merge(overrideDoc)
 		}
 	}
 
-	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
+	return mergedDoc, nil
+}
+
+// SetPolicyDocument sets the computed attributes in PolicyDocumentSchema from a policy document.
+func SetPolicyDocument(d *schema.ResourceData, doc *IAMPolicyDoc) error {
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+		return fmt.Errorf("formatting JSON: %w", err)
 	}
 	jsonString := string(jsonDoc)
 
 	d.Set(names.AttrJSON, jsonString)
 
-	jsonMinDoc, err := json.Marshal(mergedDoc)
+	jsonMinDoc, err := json.Marshal(doc)
 	if err != nil {
 		// should never happen if the above code is correct
-		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+		return fmt.Errorf("formatting JSON: %w", err)
 	}
 	jsonMinString := string(jsonMinDoc)
 
@@ -325,7 +349,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
-	return diags
+	return nil
 }
 
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
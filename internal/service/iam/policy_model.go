@@ -82,6 +82,18 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// Normalize rewrites the document's statements into canonical form.
+// Action and Resource lists are de-duplicated and reverse-sorted, and single element lists are
+// collapsed to a string, matching the output of the aws_iam_policy_document data source.
+func (s *IAMPolicyDoc) Normalize() {
+	for _, stmt := range s.Statements {
+		stmt.Actions = policyNormalizeStringList(stmt.Actions)
+		stmt.NotActions = policyNormalizeStringList(stmt.NotActions)
+		stmt.Resources = policyNormalizeStringList(stmt.Resources)
+		stmt.NotResources = policyNormalizeStringList(stmt.NotResources)
+	}
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -246,6 +258,43 @@ func policyDecodeConfigStringList(lI []interface{}) interface{} {
 	return ret
 }
 
+// policyNormalizeStringList de-duplicates a decoded JSON string or list of strings.
+// Values of any other type are returned unchanged.
+func policyNormalizeStringList(v interface{}) interface{} {
+	var lI []interface{}
+
+	switch v := v.(type) {
+	case []interface{}:
+		lI = v
+	case []string:
+		for _, s := range v {
+			lI = append(lI, s)
+		}
+	default:
+		return v
+	}
+
+	seen := make(map[string]struct{}, len(lI))
+	out := make([]interface{}, 0, len(lI))
+	for _, vI := range lI {
+		s, ok := vI.(string)
+		if !ok {
+			return v
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+
+	return policyDecodeConfigStringList(out)
+}
+
 // PolicyHasValidAWSPrincipals validates that the Principals in an IAM Policy are valid
 // Assumes that non-"AWS" Principals are valid
 // The value can be a single string or a slice of strings
@@ -431,3 +431,52 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestIAMPolicyDocNormalize(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy string
+		want   string
+	}{
+		"string values": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"single element lists": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"unsorted lists with duplicates": {
+			policy: `{"Statement":[{"Effect":"Deny","NotAction":["s3:GetObject","iam:*","s3:GetObject"],"NotResource":["arn:aws:s3:::b","arn:aws:s3:::a"]}]}`,
+			want:   `{"Statement":[{"Effect":"Deny","NotAction":["s3:GetObject","iam:*"],"NotResource":["arn:aws:s3:::b","arn:aws:s3:::a"]}]}`,
+		},
+		"principals and conditions": {
+			policy: `{"Statement":[{"Sid":"A","Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"Service":["lambda.amazonaws.com","ec2.amazonaws.com"]},"Condition":{"StringEquals":{"aws:SourceAccount":["123456789012"]}}}]}`,
+			want:   `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":["lambda.amazonaws.com","ec2.amazonaws.com"]},"Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+		},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc tfiam.IAMPolicyDoc
+			if err := json.Unmarshal([]byte(tc.policy), &doc); err != nil {
+				t.Fatal(err)
+			}
+
+			doc.Normalize()
+
+			got, err := json.Marshal(&doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != tc.want {
+				t.Errorf("IAMPolicyDoc.Normalize() = %s, want %s", string(got), tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// ResourcePolicyDocument builds the data source for the resource policy documents of a service, e.g. S3 bucket policies.
+// The data source has the arguments of aws_iam_policy_document, and the merged document is validated against the service:
+// every statement must have a principal, actions must belong to the service and condition keys must be global or belong to the service.
+type ResourcePolicyDocument struct {
+	// Name of the policy document, e.g. "S3 Bucket Policy Document", used in error messages.
+	Name string
+	// ServicePrefix is the prefix of the service's actions and condition keys, e.g. "s3".
+	ServicePrefix string
+	// Schema contains additional service-specific arguments.
+	Schema map[string]*schema.Schema
+	// DefaultStatements returns the statements that precede the configured statements.
+	// A configured statement with the same Sid replaces a default statement.
+	DefaultStatements func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*IAMPolicyStatement, error)
+	// ValidateStatement performs additional service-specific validation of a statement.
+	ValidateStatement func(stmt *IAMPolicyStatement) error
+}
+
+// DataSource returns the data source.
+func (r ResourcePolicyDocument) DataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: r.read,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := PolicyDocumentSchema()
+
+			delete(s, "override_json")
+			delete(s, "source_json")
+
+			for k, v := range r.Schema {
+				s[k] = v
+			}
+
+			return s
+		},
+	}
+}
+
+func (r ResourcePolicyDocument) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &IAMPolicyDoc{}
+
+	if r.DefaultStatements != nil {
+		stmts, err := r.DefaultStatements(ctx, d, meta)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing %s: %s", r.Name, err)
+		}
+
+		doc.Statements = stmts
+	}
+
+	configuredDoc, err := ExpandPolicyDocument(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing %s: %s", r.Name, err)
+	}
+
+	doc.Merge(configuredDoc)
+
+	if err := r.Validate(doc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing %s: %s", r.Name, err)
+	}
+
+	if err := SetPolicyDocument(d, doc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing %s: %s", r.Name, err)
+	}
+
+	return diags
+}
+
+// Validate validates each statement of a resource policy document.
+func (r ResourcePolicyDocument) Validate(doc *IAMPolicyDoc) error {
+	var errs []error
+
+	for i, stmt := range doc.Statements {
+		if err := r.validateStatement(stmt); err != nil {
+			if stmt.Sid != "" {
+				errs = append(errs, fmt.Errorf("statement %q: %w", stmt.Sid, err))
+			} else {
+				errs = append(errs, fmt.Errorf("statement %d: %w", i, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r ResourcePolicyDocument) validateStatement(stmt *IAMPolicyStatement) error {
+	var errs []error
+
+	if len(stmt.Principals) == 0 && len(stmt.NotPrincipals) == 0 {
+		errs = append(errs, errors.New("resource policy statements must have principals or not_principals"))
+	}
+
+	actions := append(policyStringList(stmt.Actions), policyStringList(stmt.NotActions)...)
+
+	if len(actions) == 0 {
+		errs = append(errs, errors.New("statements must have actions or not_actions"))
+	}
+
+	for _, action := range actions {
+		if action != "*" && !strings.HasPrefix(strings.ToLower(action), r.ServicePrefix+":") {
+			errs = append(errs, fmt.Errorf("action %q is not a %s action", action, r.ServicePrefix))
+		}
+	}
+
+	for _, condition := range stmt.Conditions {
+		if prefix, _, _ := strings.Cut(strings.ToLower(condition.Variable), ":"); prefix != "aws" && prefix != r.ServicePrefix {
+			errs = append(errs, fmt.Errorf("condition key %q is not a global or %s condition key", condition.Variable, r.ServicePrefix))
+		}
+	}
+
+	if r.ValidateStatement != nil {
+		if err := r.ValidateStatement(stmt); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// PolicyStatementResources returns the resources of a statement.
+func PolicyStatementResources(stmt *IAMPolicyStatement) []string {
+	return policyStringList(stmt.Resources)
+}
+
+// policyStringList returns the strings in a configured or decoded JSON string or list of strings.
+func policyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var out []string
+		for _, vI := range v {
+			if s, ok := vI.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
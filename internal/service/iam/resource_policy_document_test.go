@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestResourcePolicyDocumentValidate(t *testing.T) {
+	t.Parallel()
+
+	principals := tfiam.IAMPolicyStatementPrincipalSet{
+		{Type: "AWS", Identifiers: "arn:aws:iam::123456789012:root"},
+	}
+
+	testCases := map[string]struct {
+		statement     *tfiam.IAMPolicyStatement
+		expectedError string
+	}{
+		"valid": {
+			statement: &tfiam.IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    []string{"sqs:SendMessage", "SQS:ReceiveMessage"},
+				Principals: principals,
+				Conditions: tfiam.IAMPolicyStatementConditionSet{
+					{Test: "ArnEquals", Variable: "aws:SourceArn", Values: "arn:aws:sns:us-west-2:123456789012:topic"},
+					{Test: "StringEquals", Variable: "sqs:Attribute", Values: "value"},
+				},
+			},
+		},
+		"all actions": {
+			statement: &tfiam.IAMPolicyStatement{
+				Effect:        "Deny",
+				NotActions:    "*",
+				NotPrincipals: principals,
+			},
+		},
+		"decoded JSON": {
+			statement: &tfiam.IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    []interface{}{"sqs:SendMessage"},
+				Principals: principals,
+			},
+		},
+		"no principal": {
+			statement: &tfiam.IAMPolicyStatement{
+				Effect:  "Allow",
+				Actions: "sqs:SendMessage",
+			},
+			expectedError: `statement 0: resource policy statements must have principals or not_principals`,
+		},
+		"no action": {
+			statement: &tfiam.IAMPolicyStatement{
+				Sid:        "NoAction",
+				Effect:     "Allow",
+				Principals: principals,
+			},
+			expectedError: `statement "NoAction": statements must have actions or not_actions`,
+		},
+		"other service action": {
+			statement: &tfiam.IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    []string{"sqs:SendMessage", "sns:Publish"},
+				Principals: principals,
+			},
+			expectedError: `statement 0: action "sns:Publish" is not a sqs action`,
+		},
+		"other service condition key": {
+			statement: &tfiam.IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    "sqs:SendMessage",
+				Principals: principals,
+				Conditions: tfiam.IAMPolicyStatementConditionSet{
+					{Test: "StringEquals", Variable: "s3:prefix", Values: "home/"},
+				},
+			},
+			expectedError: `statement 0: condition key "s3:prefix" is not a global or sqs condition key`,
+		},
+		"service-specific": {
+			statement: &tfiam.IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    "sqs:*",
+				Principals: principals,
+			},
+			expectedError: `statement 0: all actions`,
+		},
+	}
+
+	r := tfiam.ResourcePolicyDocument{
+		Name:          "SQS Queue Policy Document",
+		ServicePrefix: "sqs",
+		ValidateStatement: func(stmt *tfiam.IAMPolicyStatement) error {
+			if stmt.Actions == "sqs:*" {
+				return errors.New("all actions")
+			}
+
+			return nil
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := r.Validate(&tfiam.IAMPolicyDoc{
+				Version:    "2012-10-17",
+				Statements: []*tfiam.IAMPolicyStatement{testCase.statement},
+			})
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -60,6 +60,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_policy",
 			Name:     "Policy",
 		},
+		{
+			Factory:  dataSourcePolicyAnalysis,
+			TypeName: "aws_iam_policy_analysis",
+			Name:     "Policy Analysis",
+		},
 		{
 			Factory:  dataSourcePolicyDocument,
 			TypeName: "aws_iam_policy_document",
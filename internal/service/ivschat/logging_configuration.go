@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_ivschat_logging_configuration", name="Logging Configuration")
 // @Tags(identifierAttribute="id")
+// @Sweeper(list="ListLoggingConfigurations", idField="Arn", dependencies=[aws_ivschat_room])
 func ResourceLoggingConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLoggingConfigurationCreate,
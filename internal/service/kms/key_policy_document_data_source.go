@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// @SDKDataSource("aws_kms_key_policy_document", name="Key Policy Document")
+func dataSourceKeyPolicyDocument() *schema.Resource {
+	return tfiam.ResourcePolicyDocument{
+		Name:          "KMS Key Policy Document",
+		ServicePrefix: "kms",
+		Schema: map[string]*schema.Schema{
+			"enable_root_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+		// Without a statement allowing the account's root user, the key becomes unmanageable.
+		// See https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-default.html#key-policy-default-allow-root-enable-iam.
+		DefaultStatements: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*tfiam.IAMPolicyStatement, error) {
+			if !d.Get("enable_root_access").(bool) {
+				return nil, nil
+			}
+
+			rootARN := arn.ARN{
+				Partition: meta.(*conns.AWSClient).Partition,
+				Service:   "iam",
+				AccountID: meta.(*conns.AWSClient).AccountID,
+				Resource:  "root",
+			}.String()
+
+			return []*tfiam.IAMPolicyStatement{
+				{
+					Sid:     "EnableRootAccess",
+					Effect:  "Allow",
+					Actions: "kms:*",
+					Principals: tfiam.IAMPolicyStatementPrincipalSet{
+						{Type: "AWS", Identifiers: rootARN},
+					},
+					Resources: "*",
+				},
+			}, nil
+		},
+		ValidateStatement: func(stmt *tfiam.IAMPolicyStatement) error {
+			// In a key policy, the resource is always the KMS key to which the policy is attached.
+			if resources := tfiam.PolicyStatementResources(stmt); len(resources) != 1 || resources[0] != "*" || stmt.NotResources != nil {
+				return errors.New(`key policy statements must have the resource "*"`)
+			}
+
+			return nil
+		},
+	}.DataSource()
+}
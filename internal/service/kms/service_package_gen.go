@@ -45,6 +45,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_kms_key",
 			Name:     "Key",
 		},
+		{
+			Factory:  dataSourceKeyPolicyDocument,
+			TypeName: "aws_kms_key_policy_document",
+			Name:     "Key Policy Document",
+		},
 		{
 			Factory:  dataSourcePublicKey,
 			TypeName: "aws_kms_public_key",
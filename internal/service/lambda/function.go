@@ -174,7 +174,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -213,7 +213,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -327,7 +327,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -338,7 +338,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", names.AttrSource},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -371,6 +371,12 @@ func resourceFunction() *schema.Resource {
 					},
 				},
 			},
+			names.AttrSource: func() *schema.Schema {
+				schema := sourceSchema()
+				schema.ExactlyOneOf = []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource}
+				schema.ConflictsWith = []string{"source_code_hash"}
+				return schema
+			}(),
 			"source_code_hash": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -459,6 +465,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			setSourceCodeHashFromSource,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -498,6 +505,22 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if v, ok := d.GetOk(names.AttrSource); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := expandSourceConfig(v.([]interface{})).build(ctx, meta, functionName, d.Get("source_code_hash").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building Lambda Function (%s) source: %s", functionName, err)
+		}
+
+		if code.zipFile != nil {
+			input.Code.ZipFile = code.zipFile
+		} else {
+			input.Code.S3Bucket = aws.String(code.s3Bucket)
+			input.Code.S3Key = aws.String(code.s3Key)
+		}
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -964,6 +987,22 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk(names.AttrSource); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := expandSourceConfig(v.([]interface{})).build(ctx, meta, d.Id(), d.Get("source_code_hash").(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "building Lambda Function (%s) source: %s", d.Id(), err)
+			}
+
+			if code.zipFile != nil {
+				input.ZipFile = code.zipFile
+			} else {
+				input.S3Bucket = aws.String(code.s3Bucket)
+				input.S3Key = aws.String(code.s3Key)
+			}
 		} else {
 			input.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -1806,6 +1806,43 @@ func TestAccLambdaFunction_s3(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_source(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	dir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccFunctionCopySourceFile(t, "test-fixtures/lambda_func.js", filepath.Join(dir, "lambda.js"))
+				},
+				Config: testAccFunctionConfig_source(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "code_sha256", resourceName, "source_code_hash"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccFunctionCopySourceFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(dir, "lambda.js"))
+				},
+				Config: testAccFunctionConfig_source(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "code_sha256", resourceName, "source_code_hash"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2551,6 +2588,37 @@ resource "aws_lambda_function" "test" {
 `, funcName))
 }
 
+func testAccFunctionConfig_source(rName, dir string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda.handler"
+  runtime       = "nodejs16.x"
+
+  source {
+    directory = %[2]q
+    excludes  = ["*.map"]
+  }
+}
+`, rName, dir))
+}
+
+func testAccFunctionCopySourceFile(t *testing.T, src, dst string) {
+	t.Helper()
+
+	b, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(dst, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccFunctionConfig_snapStartEnabled(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -83,7 +83,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version", names.AttrSource},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -104,19 +104,19 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", names.AttrSource},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", names.AttrSource},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", names.AttrSource},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -132,6 +132,12 @@ func resourceLayerVersion() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			names.AttrSource: func() *schema.Schema {
+				schema := sourceSchema()
+				schema.ForceNew = true
+				schema.ConflictsWith = []string{"filename", names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash"}
+				return schema
+			}(),
 			"source_code_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -147,6 +153,8 @@ func resourceLayerVersion() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: setSourceCodeHashFromSource,
 	}
 }
 
@@ -160,12 +168,33 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	source, hasSource := d.GetOk(names.AttrSource)
+
+	if !hasFilename && !hasSource && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source or s3_* attributes must be set")
 	}
 
 	var layerContent *awstypes.LayerVersionContentInput
-	if hasFilename {
+	if hasSource {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		code, err := expandSourceConfig(source.([]interface{})).build(ctx, meta, layerName, d.Get("source_code_hash").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building Lambda Layer Version (%s) source: %s", layerName, err)
+		}
+
+		if code.zipFile != nil {
+			layerContent = &awstypes.LayerVersionContentInput{
+				ZipFile: code.zipFile,
+			}
+		} else {
+			layerContent = &awstypes.LayerVersionContentInput{
+				S3Bucket: aws.String(code.s3Bucket),
+				S3Key:    aws.String(code.s3Key),
+			}
+		}
+	} else if hasFilename {
 		conns.GlobalMutexKV.Lock(mutexLayerKey)
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 
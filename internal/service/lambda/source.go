@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// sourceArchiveDirectUploadMaxSize is the maximum size of a deployment package uploaded directly to Lambda.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourceArchiveDirectUploadMaxSize = 50 * 1024 * 1024
+)
+
+var (
+	// sourceArchiveModTime is the modification time of every file in a source archive.
+	// It is the earliest time that can be represented in a ZIP file.
+	sourceArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func sourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"directory": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"excludes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validSourcePattern,
+					},
+				},
+				"executables": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validSourcePattern,
+					},
+				},
+				"includes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validSourcePattern,
+					},
+				},
+				names.AttrS3Bucket: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"s3_key_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// sourceConfig is the configuration of a deployment package built from a local directory.
+type sourceConfig struct {
+	directory   string
+	excludes    []string
+	executables []string
+	includes    []string
+	s3Bucket    string
+	s3KeyPrefix string
+}
+
+func expandSourceConfig(tfList []interface{}) *sourceConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &sourceConfig{
+		directory:   tfMap["directory"].(string),
+		excludes:    flex.ExpandStringValueSet(tfMap["excludes"].(*schema.Set)),
+		executables: flex.ExpandStringValueSet(tfMap["executables"].(*schema.Set)),
+		includes:    flex.ExpandStringValueSet(tfMap["includes"].(*schema.Set)),
+		s3Bucket:    tfMap[names.AttrS3Bucket].(string),
+		s3KeyPrefix: tfMap["s3_key_prefix"].(string),
+	}
+}
+
+// matchSourcePattern returns whether the slash-separated relative path matches the glob pattern.
+// A pattern without a `/` is matched against the file name only, in any directory.
+func matchSourcePattern(pattern, relativePath string) bool {
+	name := relativePath
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(relativePath)
+	}
+
+	ok, _ := path.Match(pattern, name)
+
+	return ok
+}
+
+func matchAnySourcePattern(patterns []string, relativePath string) bool {
+	for _, pattern := range patterns {
+		if matchSourcePattern(pattern, relativePath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// archive returns a ZIP archive of the files in the source directory.
+// The archive's content depends only on the files' relative paths and contents, and on the configuration:
+// files are added in lexical order, with the same modification time, and with mode 0755 if they match
+// an `executables` pattern or 0644 otherwise.
+func (c *sourceConfig) archive() ([]byte, error) {
+	root, err := homedir.Expand(c.directory)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source directory (%s): %w", c.directory, err)
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	err = filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Symbolic links are followed.
+		info, err := os.Stat(filePath)
+
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		relativePath = filepath.ToSlash(relativePath)
+
+		if len(c.includes) > 0 && !matchAnySourcePattern(c.includes, relativePath) {
+			return nil
+		}
+
+		if matchAnySourcePattern(c.excludes, relativePath) {
+			return nil
+		}
+
+		header := &zip.FileHeader{
+			Name:     relativePath,
+			Method:   zip.Deflate,
+			Modified: sourceArchiveModTime,
+		}
+		if matchAnySourcePattern(c.executables, relativePath) {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		fw, err := w.CreateHeader(header)
+
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(filePath)
+
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(fw, file)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("archiving source directory (%s): %w", c.directory, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("archiving source directory (%s): %w", c.directory, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sourceArchiveHash returns the base64-encoded SHA-256 hash of the archive,
+// the same value as the function's or layer version's `code_sha256`.
+func sourceArchiveHash(archive []byte) string {
+	hash := sha256.Sum256(archive)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// sourceCode is a deployment package built from a local directory.
+// It is either uploaded directly or, if too large, from an S3 object.
+type sourceCode struct {
+	s3Bucket string
+	s3Key    string
+	zipFile  []byte
+}
+
+// build builds the deployment package and, if it is too large to upload directly, uploads it to S3.
+// An error is returned if the package's hash isn't the one computed during plan.
+func (c *sourceConfig) build(ctx context.Context, meta interface{}, name, sourceCodeHash string) (*sourceCode, error) {
+	archive, err := c.archive()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if hash := sourceArchiveHash(archive); hash != sourceCodeHash {
+		return nil, fmt.Errorf("source directory (%s) changed after plan: source_code_hash %s, expected %s", c.directory, hash, sourceCodeHash)
+	}
+
+	if len(archive) <= sourceArchiveDirectUploadMaxSize {
+		return &sourceCode{
+			zipFile: archive,
+		}, nil
+	}
+
+	if c.s3Bucket == "" {
+		return nil, fmt.Errorf("source archive is %d bytes, larger than the %d byte direct upload limit: source.s3_bucket must be set", len(archive), sourceArchiveDirectUploadMaxSize)
+	}
+
+	hash := sha256.Sum256(archive)
+	key := fmt.Sprintf("%s%s/%s.zip", c.s3KeyPrefix, name, hex.EncodeToString(hash[:]))
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	_, err = conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(archive),
+		Bucket: aws.String(c.s3Bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading source archive to S3 Bucket (%s) Object (%s): %w", c.s3Bucket, key, err)
+	}
+
+	return &sourceCode{
+		s3Bucket: c.s3Bucket,
+		s3Key:    key,
+	}, nil
+}
+
+// setSourceCodeHashFromSource builds the deployment package configured in the `source` block
+// and sets `source_code_hash` to the package's hash.
+func setSourceCodeHashFromSource(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk(names.AttrSource)
+
+	if !ok {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrSource) {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	archive, err := expandSourceConfig(v.([]interface{})).archive()
+
+	if err != nil {
+		return err
+	}
+
+	if hash := sourceArchiveHash(archive); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
+func validSourcePattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, v, err))
+	}
+
+	return
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMatchSourcePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern      string
+		relativePath string
+		expected     bool
+	}{
+		{
+			pattern:      "*.py",
+			relativePath: "handler.py",
+			expected:     true,
+		},
+		{
+			pattern:      "*.py",
+			relativePath: "lib/util.py",
+			expected:     true,
+		},
+		{
+			pattern:      "lib/*",
+			relativePath: "lib/util.py",
+			expected:     true,
+		},
+		{
+			pattern:      "lib/*",
+			relativePath: "handler.py",
+		},
+		{
+			pattern:      "*.pyc",
+			relativePath: "handler.py",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.pattern+" "+testCase.relativePath, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := matchSourcePattern(testCase.pattern, testCase.relativePath), testCase.expected; got != want {
+				t.Errorf("matchSourcePattern(%q, %q) = %t, want %t", testCase.pattern, testCase.relativePath, got, want)
+			}
+		})
+	}
+}
+
+func TestSourceConfigArchive(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"handler.py":             "def handler(event, context): pass",
+		"bootstrap":              "#!/bin/sh",
+		"lib/util.py":            "pass",
+		"lib/__pycache__/x.pyc":  "compiled",
+		"tests/test_handler.py":  "pass",
+		"requirements-dev.txt":   "pytest",
+		"lib/data/settings.json": "{}",
+	}
+
+	config := func(directory string) *sourceConfig {
+		return &sourceConfig{
+			directory:   directory,
+			excludes:    []string{"*.pyc", "tests/*", "*.txt"},
+			executables: []string{"bootstrap"},
+		}
+	}
+
+	// The same files written at different times and with different modes produce the same archive.
+	dir1 := testSourceCreateDir(t, files, 0600, time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC))
+	dir2 := testSourceCreateDir(t, files, 0755, time.Now())
+
+	archive1, err := config(dir1).archive()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive2, err := config(dir2).archive()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := sourceArchiveHash(archive2), sourceArchiveHash(archive1); got != want {
+		t.Errorf("source_code_hash = %s, want %s", got, want)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive1), int64(len(archive1)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]os.FileMode)
+	for _, f := range r.File {
+		got[f.Name] = f.Mode()
+
+		if !f.Modified.Equal(sourceArchiveModTime) {
+			t.Errorf("%s modified %s, want %s", f.Name, f.Modified, sourceArchiveModTime)
+		}
+	}
+
+	want := map[string]os.FileMode{
+		"bootstrap":              0755,
+		"handler.py":             0644,
+		"lib/data/settings.json": 0644,
+		"lib/util.py":            0644,
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Only files matching an include pattern are archived.
+	c := config(dir1)
+	c.includes = []string{"*.py"}
+
+	archive3, err := c.archive()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = zip.NewReader(bytes.NewReader(archive3), int64(len(archive3)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(r.File), 2; got != want {
+		t.Errorf("got %d files, want %d", got, want)
+	}
+}
+
+func testSourceCreateDir(t *testing.T, files map[string]string, mode os.FileMode, modTime time.Time) string {
+	dir := t.TempDir()
+
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(data), mode); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
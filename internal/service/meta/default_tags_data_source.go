@@ -58,7 +58,8 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
-	defaultTagsConfig := d.Meta().DefaultTagsConfig
+	// The default tags in Context have any placeholders resolved and any `override` blocks applied.
+	defaultTagsConfig := d.Meta().DefaultTagsConfigFromContext(ctx)
 	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 	tags := defaultTagsConfig.GetTags()
 
@@ -4,14 +4,96 @@
 package meta_test
 
 import (
+	"context"
+	"maps"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestDefaultTagsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &conns.AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(ctx, map[string]any{
+				"Location": "${region}",
+				"Owner":    "provider",
+			}),
+			Overrides: []tftags.DefaultTagsOverride{
+				{
+					ResourceTypes: []string{"aws_default_*"},
+					Tags:          tftags.New(ctx, map[string]any{"Owner": "override"}),
+				},
+				{
+					ResourceTypes: []string{"aws_instance"},
+					Tags:          tftags.New(ctx, map[string]any{"Owner": "instance"}),
+				},
+			},
+		},
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	d, err := tfmeta.NewDataSourceDefaultTags(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &datasource.ConfigureResponse{})
+
+	var schemaResponse datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+	s := schemaResponse.Schema
+	typ := s.Type().TerraformType(ctx)
+
+	// The provider places the data source's default tags in Context.
+	ctx = conns.NewDataSourceContext(ctx, "meta", "Default Tags", "aws_default_tags")
+	ctx = tftags.NewContext(ctx, client.DefaultTagsConfigForResource("meta", "aws_default_tags"), nil, nil)
+
+	request := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+				names.AttrID:   tftypes.NewValue(tftypes.String, nil),
+				names.AttrTags: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}),
+			Schema: s,
+		},
+	}
+	response := datasource.ReadResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(typ, nil), Schema: s},
+	}
+
+	d.Read(ctx, request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	var got map[string]string
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(names.AttrTags), &got)...)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	want := map[string]string{
+		"Location": "us-west-2", //lintignore:AWSAT003
+		"Owner":    "override",
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("tags = %v, want %v", got, want)
+	}
+}
+
 func TestAccMetaDefaultTagsDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_default_tags.test"
@@ -18,6 +18,7 @@ var (
 	ResourceVPCAssociationAuthorization = resourceVPCAssociationAuthorization
 	ResourceZone                        = resourceZone
 	ResourceZoneAssociation             = resourceZoneAssociation
+	ResourceZoneRecords                 = resourceZoneRecords
 
 	CleanDelegationSetID                        = cleanDelegationSetID
 	CleanRecordName                             = cleanRecordName
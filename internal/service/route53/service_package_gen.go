@@ -44,6 +44,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_route53_zone",
 			Name:     "Hosted Zone",
 		},
+		{
+			Factory:  dataSourceZoneRecords,
+			TypeName: "aws_route53_zone_records",
+			Name:     "Zone Records",
+		},
 	}
 }
 
@@ -112,6 +117,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_route53_zone_association",
 			Name:     "Zone Association",
 		},
+		{
+			Factory:  resourceZoneRecords,
+			TypeName: "aws_route53_zone_records",
+			Name:     "Zone Records",
+		},
 	}
 }
 
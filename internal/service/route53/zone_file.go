@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"bufio"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+const (
+	// See https://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html#API_ChangeResourceRecordSets_RequestSyntax.
+	changeBatchMaxResourceRecords = 1000
+)
+
+// parseZoneFile parses a BIND format zone file into Route 53 resource record sets.
+// Names that are not fully qualified are relative to origin, or to the zone file's $ORIGIN directive.
+// Records that Route 53 manages itself, the SOA record and the NS records at the zone apex, are omitted.
+func parseZoneFile(zoneFile, origin string) ([]awstypes.ResourceRecordSet, error) {
+	p := &zoneFileParser{
+		apex:   fqdn(strings.ToLower(origin)),
+		origin: fqdn(strings.ToLower(origin)),
+	}
+
+	if err := p.parse(zoneFile); err != nil {
+		return nil, err
+	}
+
+	return p.recordSets, nil
+}
+
+type zoneFileParser struct {
+	apex       string
+	defaultTTL *int64
+	lastName   string
+	lastTTL    *int64
+	origin     string
+	recordSets []awstypes.ResourceRecordSet
+}
+
+func (p *zoneFileParser) parse(zoneFile string) error {
+	scanner := bufio.NewScanner(strings.NewReader(zoneFile))
+	var entry []string
+	var startLine, depth int
+	var continued bool
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		tokens, d, err := tokenizeZoneFileLine(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNo, err)
+		}
+
+		if depth == 0 {
+			if len(tokens) == 0 {
+				continue
+			}
+			startLine = lineNo
+			// An entry starting with whitespace belongs to the previous owner name.
+			continued = line[0] == ' ' || line[0] == '\t'
+		}
+		entry = append(entry, tokens...)
+
+		if depth += d; depth < 0 {
+			return fmt.Errorf("line %d: unbalanced parentheses", lineNo)
+		}
+		if depth > 0 {
+			continue
+		}
+
+		if err := p.parseEntry(entry, continued); err != nil {
+			return fmt.Errorf("line %d: %w", startLine, err)
+		}
+		entry = nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if depth != 0 {
+		return fmt.Errorf("line %d: unbalanced parentheses", startLine)
+	}
+
+	return nil
+}
+
+// tokenizeZoneFileLine splits a zone file line into tokens, dropping comments and parentheses.
+// Quoted strings are returned as a single token including their quotes.
+// The change in parenthesis depth is also returned.
+func tokenizeZoneFileLine(line string) ([]string, int, error) {
+	var tokens []string
+	var depth int
+
+	for i := 0; i < len(line); {
+		switch c := line[i]; {
+		case c == ';':
+			return tokens, depth, nil
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			depth++
+			i++
+		case c == ')':
+			depth--
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(line) && line[j] != '"'; j++ {
+				if line[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(line) {
+				return nil, 0, fmt.Errorf("unterminated quoted string")
+			}
+			tokens = append(tokens, line[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(line) && !strings.ContainsRune(" \t;()\"", rune(line[j])); j++ {
+				if line[j] == '\\' {
+					j++
+				}
+			}
+			if j > len(line) {
+				j = len(line)
+			}
+			tokens = append(tokens, line[i:j])
+			i = j
+		}
+	}
+
+	return tokens, depth, nil
+}
+
+func (p *zoneFileParser) parseEntry(tokens []string, continued bool) error {
+	switch directive := strings.ToUpper(tokens[0]); directive {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("%s requires a single domain name", directive)
+		}
+		p.origin = p.qualify(tokens[1])
+		return nil
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("%s requires a single TTL", directive)
+		}
+		ttl, err := parseZoneFileTTL(tokens[1])
+		if err != nil {
+			return err
+		}
+		p.defaultTTL = aws.Int64(ttl)
+		return nil
+	case "$INCLUDE", "$GENERATE":
+		return fmt.Errorf("%s directive is not supported", directive)
+	}
+
+	name := p.lastName
+	if !continued {
+		name = p.qualify(tokens[0])
+		tokens = tokens[1:]
+	}
+	if name == "" {
+		return fmt.Errorf("record has no owner name")
+	}
+	p.lastName = name
+
+	// TTL and class may appear in either order before the type.
+	var ttl *int64
+	for len(tokens) > 0 {
+		if strings.EqualFold(tokens[0], "IN") {
+			tokens = tokens[1:]
+			continue
+		}
+		if v, err := parseZoneFileTTL(tokens[0]); err == nil && ttl == nil {
+			ttl = aws.Int64(v)
+			tokens = tokens[1:]
+			continue
+		}
+		break
+	}
+
+	if len(tokens) < 2 {
+		return fmt.Errorf("record %s has no type or data", name)
+	}
+
+	rrType := awstypes.RRType(strings.ToUpper(tokens[0]))
+	if !slices.Contains(enum.EnumValues[awstypes.RRType](), rrType) {
+		return fmt.Errorf("record %s has unsupported type %q", name, tokens[0])
+	}
+
+	switch {
+	case ttl != nil:
+		p.lastTTL = ttl
+	case p.defaultTTL != nil:
+		ttl = p.defaultTTL
+	case p.lastTTL != nil:
+		ttl = p.lastTTL
+	default:
+		return fmt.Errorf("record %s has no TTL and no $TTL directive precedes it", name)
+	}
+
+	if rrType == awstypes.RRTypeSoa || (rrType == awstypes.RRTypeNs && name == p.apex) {
+		return nil
+	}
+
+	value := p.qualifyData(rrType, tokens[1:])
+
+	for i, v := range p.recordSets {
+		if aws.ToString(v.Name) != name || v.Type != rrType {
+			continue
+		}
+		if aws.ToInt64(v.TTL) != aws.ToInt64(ttl) {
+			return fmt.Errorf("records %s %s have different TTLs", name, rrType)
+		}
+		p.recordSets[i].ResourceRecords = append(v.ResourceRecords, awstypes.ResourceRecord{Value: aws.String(value)})
+		return nil
+	}
+
+	p.recordSets = append(p.recordSets, awstypes.ResourceRecordSet{
+		Name:            aws.String(name),
+		ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(value)}},
+		TTL:             ttl,
+		Type:            rrType,
+	})
+
+	return nil
+}
+
+// qualify returns the fully qualified, lowercase form of a domain name in the zone file.
+func (p *zoneFileParser) qualify(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	case p.origin == ".":
+		return strings.ToLower(name) + "."
+	default:
+		return strings.ToLower(name) + "." + p.origin
+	}
+}
+
+// qualifyData returns the record data with any domain names in it fully qualified.
+func (p *zoneFileParser) qualifyData(rrType awstypes.RRType, data []string) string {
+	var i int
+	switch rrType {
+	case awstypes.RRTypeCname, awstypes.RRTypeNs, awstypes.RRTypePtr:
+		i = 0
+	case awstypes.RRTypeMx:
+		i = 1
+	case awstypes.RRTypeSrv:
+		i = 3
+	default:
+		i = -1
+	}
+
+	data = slices.Clone(data)
+	if i >= 0 && i < len(data) {
+		data[i] = p.qualify(data[i])
+	}
+
+	return strings.Join(data, " ")
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in BIND's unit format, e.g. "1h30m".
+func parseZoneFileTTL(s string) (int64, error) {
+	if v, err := strconv.ParseUint(s, 10, 31); err == nil {
+		return int64(v), nil
+	}
+
+	if s == "" {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	var ttl, n int64
+	var digits bool
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+
+		switch c {
+		case 's':
+		case 'm':
+			n *= 60
+		case 'h':
+			n *= 60 * 60
+		case 'd':
+			n *= 24 * 60 * 60
+		case 'w':
+			n *= 7 * 24 * 60 * 60
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		ttl += n
+		n, digits = 0, false
+	}
+
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return ttl, nil
+}
+
+// formatZoneFile returns the resource record sets of the zone named origin in BIND format.
+// Names are written relative to origin. Record sets that cannot be represented in a zone file are skipped.
+func formatZoneFile(recordSets []awstypes.ResourceRecordSet, origin string) string {
+	origin = fqdn(strings.ToLower(origin))
+	recordSets = slices.Clone(recordSets)
+	sortResourceRecordSets(recordSets)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "$ORIGIN %s\n", origin)
+
+	for _, v := range recordSets {
+		if !isZoneFileRecordSet(v) {
+			continue
+		}
+
+		name := fqdn(strings.ToLower(cleanRecordName(aws.ToString(v.Name))))
+		switch {
+		case name == origin:
+			name = "@"
+		case strings.HasSuffix(name, "."+origin):
+			name = strings.TrimSuffix(name, "."+origin)
+		}
+
+		for _, rr := range v.ResourceRecords {
+			fmt.Fprintf(&sb, "%s\t%d\tIN\t%s\t%s\n", name, aws.ToInt64(v.TTL), v.Type, aws.ToString(rr.Value))
+		}
+	}
+
+	return sb.String()
+}
+
+// isZoneFileRecordSet returns whether a resource record set can be represented in a zone file.
+// Alias records and records with a routing policy cannot.
+func isZoneFileRecordSet(v awstypes.ResourceRecordSet) bool {
+	return v.AliasTarget == nil && v.SetIdentifier == nil && v.TrafficPolicyInstanceId == nil && len(v.ResourceRecords) > 0
+}
+
+// isZoneRecordsManagedRecordSet returns whether a resource record set in the zone named origin is reconciled by aws_route53_zone_records.
+func isZoneRecordsManagedRecordSet(v awstypes.ResourceRecordSet, origin string) bool {
+	if !isZoneFileRecordSet(v) {
+		return false
+	}
+
+	switch v.Type {
+	case awstypes.RRTypeSoa:
+		return false
+	case awstypes.RRTypeNs:
+		return !strings.EqualFold(fqdn(cleanRecordName(aws.ToString(v.Name))), fqdn(origin))
+	}
+
+	return true
+}
+
+func sortResourceRecordSets(recordSets []awstypes.ResourceRecordSet) {
+	slices.SortStableFunc(recordSets, func(a, b awstypes.ResourceRecordSet) int {
+		if c := strings.Compare(resourceRecordSetKey(a), resourceRecordSetKey(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(aws.ToString(a.SetIdentifier), aws.ToString(b.SetIdentifier))
+	})
+}
+
+// resourceRecordSetKey returns the key identifying a simple resource record set, its lowercase fully qualified name and type.
+// The name's labels are reversed so that records sort by domain hierarchy.
+func resourceRecordSetKey(v awstypes.ResourceRecordSet) string {
+	labels := strings.Split(strings.TrimSuffix(strings.ToLower(cleanRecordName(aws.ToString(v.Name))), "."), ".")
+	slices.Reverse(labels)
+
+	return strings.Join(labels, ".") + " " + string(v.Type)
+}
+
+func resourceRecordSetsEqual(a, b awstypes.ResourceRecordSet) bool {
+	if aws.ToInt64(a.TTL) != aws.ToInt64(b.TTL) || len(a.ResourceRecords) != len(b.ResourceRecords) {
+		return false
+	}
+
+	values := func(v awstypes.ResourceRecordSet) []string {
+		var s []string
+		for _, rr := range v.ResourceRecords {
+			s = append(s, aws.ToString(rr.Value))
+		}
+		slices.Sort(s)
+		return s
+	}
+
+	return slices.Equal(values(a), values(b))
+}
+
+// zoneRecordsChanges returns the changes that turn the old resource record sets into the new ones.
+// Deletions are ordered first so that a batch never holds two record sets with the same name that conflict, e.g. a CNAME and an A record.
+func zoneRecordsChanges(old, new []awstypes.ResourceRecordSet) []awstypes.Change {
+	oldByKey := make(map[string]awstypes.ResourceRecordSet, len(old))
+	for _, v := range old {
+		oldByKey[resourceRecordSetKey(v)] = v
+	}
+	newByKey := make(map[string]awstypes.ResourceRecordSet, len(new))
+	for _, v := range new {
+		newByKey[resourceRecordSetKey(v)] = v
+	}
+
+	var deletes, creates, upserts []awstypes.Change
+
+	for _, v := range old {
+		if _, ok := newByKey[resourceRecordSetKey(v)]; !ok {
+			deletes = append(deletes, awstypes.Change{Action: awstypes.ChangeActionDelete, ResourceRecordSet: &v})
+		}
+	}
+
+	for _, v := range new {
+		o, ok := oldByKey[resourceRecordSetKey(v)]
+		switch {
+		case !ok:
+			creates = append(creates, awstypes.Change{Action: awstypes.ChangeActionCreate, ResourceRecordSet: &v})
+		case !resourceRecordSetsEqual(o, v):
+			upserts = append(upserts, awstypes.Change{Action: awstypes.ChangeActionUpsert, ResourceRecordSet: &v})
+		}
+	}
+
+	return slices.Concat(deletes, creates, upserts)
+}
+
+// batchChanges splits changes into batches within the ChangeResourceRecordSets limit on the number of resource records.
+// UPSERT changes count double towards the limit.
+func batchChanges(changes []awstypes.Change, maxResourceRecords int) [][]awstypes.Change {
+	var batches [][]awstypes.Change
+	var batch []awstypes.Change
+	var n int
+
+	for _, v := range changes {
+		size := len(v.ResourceRecordSet.ResourceRecords)
+		if v.Action == awstypes.ChangeActionUpsert {
+			size *= 2
+		}
+
+		if len(batch) > 0 && n+size > maxResourceRecords {
+			batches = append(batches, batch)
+			batch, n = nil, 0
+		}
+
+		batch = append(batch, v)
+		n += size
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		zoneFile      string
+		expected      []awstypes.ResourceRecordSet
+		expectedError bool
+	}{
+		"empty": {
+			zoneFile: "",
+		},
+		"full": {
+			zoneFile: `
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+@		NS	ns1.example.com.
+@	300	IN	A	192.0.2.1
+	300	IN	A	192.0.2.2 ; continuation
+www		CNAME	@
+mail	IN	300	MX	10 mx1
+	300	MX	20 mx2.example.net.
+@		TXT	"v=spf1 -all"
+txt		TXT	"a;b" "c"
+_sip._tcp	SRV	0 5 5060 sip
+sub		NS	ns1.sub
+Other.Example.Com.	60	AAAA	2001:db8::1
+`,
+			expected: []awstypes.ResourceRecordSet{
+				{Name: aws.String("example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(300), ResourceRecords: resourceRecords("192.0.2.1", "192.0.2.2")},
+				{Name: aws.String("www.example.com."), Type: awstypes.RRTypeCname, TTL: aws.Int64(3600), ResourceRecords: resourceRecords("example.com.")},
+				{Name: aws.String("mail.example.com."), Type: awstypes.RRTypeMx, TTL: aws.Int64(300), ResourceRecords: resourceRecords("10 mx1.example.com.", "20 mx2.example.net.")},
+				{Name: aws.String("example.com."), Type: awstypes.RRTypeTxt, TTL: aws.Int64(3600), ResourceRecords: resourceRecords(`"v=spf1 -all"`)},
+				{Name: aws.String("txt.example.com."), Type: awstypes.RRTypeTxt, TTL: aws.Int64(3600), ResourceRecords: resourceRecords(`"a;b" "c"`)},
+				{Name: aws.String("_sip._tcp.example.com."), Type: awstypes.RRTypeSrv, TTL: aws.Int64(3600), ResourceRecords: resourceRecords("0 5 5060 sip.example.com.")},
+				{Name: aws.String("sub.example.com."), Type: awstypes.RRTypeNs, TTL: aws.Int64(3600), ResourceRecords: resourceRecords("ns1.sub.example.com.")},
+				{Name: aws.String("other.example.com."), Type: awstypes.RRTypeAaaa, TTL: aws.Int64(60), ResourceRecords: resourceRecords("2001:db8::1")},
+			},
+		},
+		"relative to zone": {
+			zoneFile: "www 300 A 192.0.2.1\n",
+			expected: []awstypes.ResourceRecordSet{
+				{Name: aws.String("www.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(300), ResourceRecords: resourceRecords("192.0.2.1")},
+			},
+		},
+		"nested origin": {
+			zoneFile: "$ORIGIN dev\nwww 1d A 192.0.2.1\n",
+			expected: []awstypes.ResourceRecordSet{
+				{Name: aws.String("www.dev.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(86400), ResourceRecords: resourceRecords("192.0.2.1")},
+			},
+		},
+		"no TTL": {
+			zoneFile:      "www A 192.0.2.1\n",
+			expectedError: true,
+		},
+		"conflicting TTLs": {
+			zoneFile:      "www 300 A 192.0.2.1\nwww 600 A 192.0.2.2\n",
+			expectedError: true,
+		},
+		"unsupported type": {
+			zoneFile:      "www 300 HINFO PC Linux\n",
+			expectedError: true,
+		},
+		"unbalanced parentheses": {
+			zoneFile:      "www 300 TXT ( \"a\"\n",
+			expectedError: true,
+		},
+		"unterminated string": {
+			zoneFile:      "www 300 TXT \"a\n",
+			expectedError: true,
+		},
+		"include": {
+			zoneFile:      "$INCLUDE other.zone\n",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFile(testCase.zoneFile, "example.com")
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("parseZoneFile() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(awstypes.ResourceRecordSet{}, awstypes.ResourceRecord{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ttl           string
+		expected      int64
+		expectedError bool
+	}{
+		"seconds":      {ttl: "300", expected: 300},
+		"units":        {ttl: "1h30m", expected: 5400},
+		"week":         {ttl: "1W", expected: 604800},
+		"missing unit": {ttl: "1h30", expectedError: true},
+		"unknown unit": {ttl: "1y", expectedError: true},
+		"not a TTL":    {ttl: "A", expectedError: true},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFileTTL(testCase.ttl)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("parseZoneFileTTL(%q) err %t, want %t: %v", testCase.ttl, got, want, err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("parseZoneFileTTL(%q) = %d, want %d", testCase.ttl, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFormatZoneFile(t *testing.T) {
+	t.Parallel()
+
+	recordSets := []awstypes.ResourceRecordSet{
+		{Name: aws.String("www.example.com."), Type: awstypes.RRTypeCname, TTL: aws.Int64(300), ResourceRecords: resourceRecords("example.com.")},
+		{Name: aws.String("example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(60), ResourceRecords: resourceRecords("192.0.2.1", "192.0.2.2")},
+		{Name: aws.String("\\052.example.com."), Type: awstypes.RRTypeTxt, TTL: aws.Int64(60), ResourceRecords: resourceRecords(`"wildcard"`)},
+		{Name: aws.String("alias.example.com."), Type: awstypes.RRTypeA, AliasTarget: &awstypes.AliasTarget{DNSName: aws.String("elb.amazonaws.com.")}},
+		{Name: aws.String("weighted.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(60), SetIdentifier: aws.String("a"), ResourceRecords: resourceRecords("192.0.2.3")},
+	}
+
+	got := formatZoneFile(recordSets, "example.com.")
+	expected := `$ORIGIN example.com.
+@	60	IN	A	192.0.2.1
+@	60	IN	A	192.0.2.2
+*	60	IN	TXT	"wildcard"
+www	300	IN	CNAME	example.com.
+`
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// The exported zone file parses back to the same record sets.
+	parsed, err := parseZoneFile(got, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if changes := zoneRecordsChanges(recordSets[:3], parsed); len(changes) != 0 {
+		t.Errorf("expected no changes, got %d", len(changes))
+	}
+}
+
+func TestZoneRecordsChanges(t *testing.T) {
+	t.Parallel()
+
+	old := []awstypes.ResourceRecordSet{
+		{Name: aws.String("keep.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(60), ResourceRecords: resourceRecords("192.0.2.1", "192.0.2.2")},
+		{Name: aws.String("change.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(60), ResourceRecords: resourceRecords("192.0.2.1")},
+		{Name: aws.String("remove.example.com."), Type: awstypes.RRTypeCname, TTL: aws.Int64(60), ResourceRecords: resourceRecords("example.com.")},
+	}
+	new := []awstypes.ResourceRecordSet{
+		{Name: aws.String("KEEP.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(60), ResourceRecords: resourceRecords("192.0.2.2", "192.0.2.1")},
+		{Name: aws.String("change.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(300), ResourceRecords: resourceRecords("192.0.2.1")},
+		{Name: aws.String("remove.example.com."), Type: awstypes.RRTypeA, TTL: aws.Int64(60), ResourceRecords: resourceRecords("192.0.2.3")},
+	}
+
+	var got []string
+	for _, v := range zoneRecordsChanges(old, new) {
+		got = append(got, string(v.Action)+" "+aws.ToString(v.ResourceRecordSet.Name)+" "+string(v.ResourceRecordSet.Type))
+	}
+
+	expected := []string{
+		"DELETE remove.example.com. CNAME",
+		"CREATE remove.example.com. A",
+		"UPSERT change.example.com. A",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestBatchChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(action awstypes.ChangeAction, n int) awstypes.Change {
+		var values []string
+		for i := 0; i < n; i++ {
+			values = append(values, "192.0.2.1")
+		}
+		return awstypes.Change{Action: action, ResourceRecordSet: &awstypes.ResourceRecordSet{ResourceRecords: resourceRecords(values...)}}
+	}
+
+	changes := []awstypes.Change{
+		change(awstypes.ChangeActionDelete, 4),
+		change(awstypes.ChangeActionCreate, 4),
+		change(awstypes.ChangeActionUpsert, 2),
+		change(awstypes.ChangeActionUpsert, 3),
+		change(awstypes.ChangeActionCreate, 20),
+	}
+
+	var got []int
+	for _, batch := range batchChanges(changes, 10) {
+		got = append(got, len(batch))
+	}
+
+	// An oversized change is sent in a batch of its own.
+	expected := []int{2, 2, 1}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func resourceRecords(values ...string) []awstypes.ResourceRecord {
+	var apiObjects []awstypes.ResourceRecord
+
+	for _, v := range values {
+		apiObjects = append(apiObjects, awstypes.ResourceRecord{Value: aws.String(v)})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_route53_zone_records", name="Zone Records")
+func resourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceZoneRecordsCreate,
+		ReadWithoutTimeout:   resourceZoneRecordsRead,
+		UpdateWithoutTimeout: resourceZoneRecordsUpdate,
+		DeleteWithoutTimeout: resourceZoneRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"record": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"zone_file"},
+				Elem:          zoneRecordsRecordSchema(),
+			},
+			"zone_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"record"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return zoneFilesAreEquivalent(old, new, d.Get("zone_name").(string))
+				},
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return cleanZoneID(v.(string))
+				},
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func zoneRecordsRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(v interface{}) string {
+					return normalizeZoneName(cleanRecordName(v.(string)))
+				},
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 4000),
+				},
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RRType](),
+			},
+		},
+	}
+}
+
+func resourceZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(cleanZoneID(d.Get("zone_id").(string)))
+
+	if err := reconcileZoneRecords(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceZoneRecordsRead(ctx, d, meta)...)
+}
+
+func resourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zone, err := findHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Zone Records (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	recordSets, err := findZoneRecordsManagedRecordSets(ctx, conn, d.Id(), zoneName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("record", flattenZoneRecordsRecords(recordSets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+	d.Set("zone_id", d.Id())
+	d.Set("zone_name", normalizeZoneName(zoneName))
+
+	// Keep the configured zone file while it describes the zone's records, otherwise export the records.
+	if v, ok := d.GetOk("zone_file"); ok {
+		if desired, err := parseZoneFile(v.(string), zoneName); err != nil || len(zoneRecordsChanges(recordSets, desired)) > 0 {
+			d.Set("zone_file", formatZoneFile(recordSets, zoneName))
+		}
+	}
+
+	return diags
+}
+
+func resourceZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChanges("record", "zone_file") {
+		if err := reconcileZoneRecords(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Zone Records (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceZoneRecordsRead(ctx, d, meta)...)
+}
+
+func resourceZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Route 53 Zone Records: %s", d.Id())
+	zone, err := findHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	recordSets, err := findZoneRecordsManagedRecordSets(ctx, conn, d.Id(), zoneName)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	if err := changeZoneRecords(ctx, conn, d.Id(), zoneRecordsChanges(recordSets, nil), "Deleted by Terraform"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// reconcileZoneRecords changes the zone's record sets to those in the configuration.
+func reconcileZoneRecords(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zone, err := findHostedZoneByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Hosted Zone: %w", err)
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	var desired []awstypes.ResourceRecordSet
+
+	if v, ok := d.GetOk("zone_file"); ok {
+		desired, err = parseZoneFile(v.(string), zoneName)
+		if err != nil {
+			return fmt.Errorf("parsing zone_file: %w", err)
+		}
+	} else {
+		desired = expandZoneRecordsRecords(d.Get("record").(*schema.Set).List(), zoneName)
+	}
+
+	for _, v := range desired {
+		if !isZoneRecordsManagedRecordSet(v, zoneName) {
+			return fmt.Errorf("record %s %s is managed by Route 53", aws.ToString(v.Name), v.Type)
+		}
+	}
+
+	current, err := findZoneRecordsManagedRecordSets(ctx, conn, d.Id(), zoneName)
+
+	if err != nil {
+		return err
+	}
+
+	return changeZoneRecords(ctx, conn, d.Id(), zoneRecordsChanges(current, desired), "Managed by Terraform")
+}
+
+// changeZoneRecords applies changes to a hosted zone in batches, waiting for each batch to synchronize.
+func changeZoneRecords(ctx context.Context, conn *route53.Client, zoneID string, changes []awstypes.Change, comment string) error {
+	for _, batch := range batchChanges(changes, changeBatchMaxResourceRecords) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String(comment),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
+			err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
+		}
+
+		if err != nil {
+			return fmt.Errorf("changing resource record sets: %w", err)
+		}
+
+		if output.ChangeInfo != nil {
+			if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
+				return fmt.Errorf("waiting for synchronize: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func findZoneRecordsManagedRecordSets(ctx context.Context, conn *route53.Client, zoneID, zoneName string) ([]awstypes.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	return findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		return isZoneRecordsManagedRecordSet(*v, zoneName)
+	})
+}
+
+// zoneFilesAreEquivalent returns whether two zone files describe the same record sets.
+func zoneFilesAreEquivalent(old, new, zoneName string) bool {
+	if old == new {
+		return true
+	}
+
+	if old == "" || new == "" || zoneName == "" {
+		return false
+	}
+
+	oldRecordSets, err := parseZoneFile(old, zoneName)
+	if err != nil {
+		return false
+	}
+
+	newRecordSets, err := parseZoneFile(new, zoneName)
+	if err != nil {
+		return false
+	}
+
+	return len(zoneRecordsChanges(oldRecordSets, newRecordSets)) == 0
+}
+
+func expandZoneRecordsRecords(tfList []interface{}, zoneName string) []awstypes.ResourceRecordSet {
+	var apiObjects []awstypes.ResourceRecordSet
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rrType := awstypes.RRType(tfMap[names.AttrType].(string))
+		apiObjects = append(apiObjects, awstypes.ResourceRecordSet{
+			Name:            aws.String(fqdn(strings.ToLower(expandRecordName(tfMap[names.AttrName].(string), zoneName)))),
+			ResourceRecords: expandResourceRecords(flex.ExpandStringValueSet(tfMap["records"].(*schema.Set)), rrType),
+			TTL:             aws.Int64(int64(tfMap["ttl"].(int))),
+			Type:            rrType,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenZoneRecordsRecords(apiObjects []awstypes.ResourceRecordSet) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: normalizeZoneName(cleanRecordName(aws.ToString(apiObject.Name))),
+			"records":      flattenResourceRecords(apiObject.ResourceRecords, apiObject.Type),
+			"ttl":          aws.ToInt64(apiObject.TTL),
+			names.AttrType: apiObject.Type,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @SDKDataSource("aws_route53_zone_records", name="Zone Records")
+func dataSourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceZoneRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"record": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     zoneRecordsRecordSchema(),
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zoneID := cleanZoneID(d.Get("zone_id").(string))
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	recordSets, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		return isZoneFileRecordSet(*v)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s) resource record sets: %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	if err := d.Set("record", flattenZoneRecordsRecords(recordSets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+	d.Set("zone_file", formatZoneFile(recordSets, zoneName))
+	d.Set("zone_name", normalizeZoneName(zoneName))
+
+	return diags
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneRecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsDataSourceConfig_basic(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// SOA, NS and the A record.
+					resource.TestCheckResourceAttr(dataSourceName, "record.#", "3"),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexache.MustCompile(`(?m)^www\t300\tIN\tA\t192\.0\.2\.1$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexache.MustCompile(`(?m)^@\t\d+\tIN\tSOA\t`)),
+					resource.TestCheckResourceAttr(dataSourceName, "zone_name", zoneName),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneRecordsDataSourceConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+data "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_record.test.zone_id
+}
+`, zoneName)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneRecords_zoneFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneExists(ctx, "aws_route53_zone.test", &zone),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: "www." + zoneName,
+						names.AttrType: "A",
+						"ttl":          "300",
+						"records.#":    "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: zoneName,
+						names.AttrType: "TXT",
+						"ttl":          "3600",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "zone_name", zoneName),
+				),
+			},
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "192.0.2.3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_record(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_record(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: "txt." + zoneName,
+						names.AttrType: "TXT",
+						"ttl":          "60",
+						"records.#":    "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "v=spf1 -all"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckZoneRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_zone_records" {
+				continue
+			}
+
+			output, err := tfroute53.FindHostedZoneByID(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				continue
+			}
+
+			if n := output.HostedZone.ResourceRecordSetCount; n != nil && *n > 2 {
+				return fmt.Errorf("Route 53 Hosted Zone %s still has %d record sets", rs.Primary.ID, *n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccZoneRecordsConfig_zoneFile(zoneName, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  zone_file = <<-EOT
+    $TTL 1h
+    @     TXT "v=spf1 -all"
+    www   300 IN A 192.0.2.2
+          300 IN A %[2]s
+    api   CNAME www
+  EOT
+}
+`, zoneName, address)
+}
+
+func testAccZoneRecordsConfig_record(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "txt.%[1]s"
+    type    = "TXT"
+    ttl     = 60
+    records = ["v=spf1 -all"]
+  }
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 60
+    records = ["192.0.2.1"]
+  }
+}
+`, zoneName)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_s3_bucket_policy_document", name="Bucket Policy Document")
+func dataSourceBucketPolicyDocument() *schema.Resource {
+	return tfiam.ResourcePolicyDocument{
+		Name:          "S3 Bucket Policy Document",
+		ServicePrefix: "s3",
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"deny_insecure_transport": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+		DefaultStatements: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*tfiam.IAMPolicyStatement, error) {
+			if !d.Get("deny_insecure_transport").(bool) {
+				return nil, nil
+			}
+
+			bucketARN := arn.ARN{
+				Partition: meta.(*conns.AWSClient).Partition,
+				Service:   "s3",
+				Resource:  d.Get(names.AttrBucket).(string),
+			}.String()
+
+			return []*tfiam.IAMPolicyStatement{
+				{
+					Sid:     "DenyInsecureTransport",
+					Effect:  "Deny",
+					Actions: "s3:*",
+					Principals: tfiam.IAMPolicyStatementPrincipalSet{
+						{Type: "*", Identifiers: "*"},
+					},
+					Resources: []string{bucketARN + "/*", bucketARN},
+					Conditions: tfiam.IAMPolicyStatementConditionSet{
+						{Test: "Bool", Variable: "aws:SecureTransport", Values: "false"},
+					},
+				},
+			}, nil
+		},
+	}.DataSource()
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_s3_bucket_policy_document.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyDocumentDataSourceConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "DenyInsecureTransport",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": ["arn:%[1]s:s3:::%[2]s/*", "arn:%[1]s:s3:::%[2]s"],
+      "Principal": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:%[1]s:s3:::%[2]s/*",
+      "Principal": {"AWS": "arn:%[1]s:iam::123456789012:root"}
+    }
+  ]
+}`, acctest.Partition(), rName)),
+				),
+			},
+			{
+				Config: testAccBucketPolicyDocumentDataSourceConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:%[1]s:s3:::%[2]s/*",
+      "Principal": {"AWS": "arn:%[1]s:iam::123456789012:root"}
+    }
+  ]
+}`, acctest.Partition(), rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketPolicyDocumentDataSourceConfig_invalid(rName),
+				ExpectError: regexache.MustCompile(`statement 1: resource policy statements must have principals or not_principals`),
+			},
+		},
+	})
+}
+
+func testAccBucketPolicyDocumentDataSourceConfig_basic(rName string, denyInsecureTransport bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_s3_bucket_policy_document" "test" {
+  bucket                  = %[1]q
+  deny_insecure_transport = %[2]t
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::123456789012:root"]
+    }
+  }
+}
+`, rName, denyInsecureTransport)
+}
+
+func testAccBucketPolicyDocumentDataSourceConfig_invalid(rName string) string {
+	return fmt.Sprintf(`
+data "aws_s3_bucket_policy_document" "test" {
+  bucket = %[1]q
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,688 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// directoryUploadConcurrency is the maximum number of files uploaded at the same time.
+	directoryUploadConcurrency = 10
+	// deleteObjectsMaxKeys is the maximum number of keys in a single DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+)
+
+// @SDKResource("aws_s3_directory", name="Directory")
+func resourceDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryCreate,
+		ReadWithoutTimeout:   resourceDirectoryRead,
+		UpdateWithoutTimeout: resourceDirectoryUpdate,
+		DeleteWithoutTimeout: resourceDirectoryDelete,
+
+		CustomizeDiff: resourceDirectoryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectCannedACL](),
+			},
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"bucket_key_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"checksum_algorithm": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ChecksumAlgorithm](),
+			},
+			"default_content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_extraneous_without_key_prefix": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^$|/$`), "must be empty or end with a /"),
+			},
+			names.AttrKMSKeyID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrRule: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrContentType: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateMetadataIsLowerCase,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateGlobPattern,
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			names.AttrSource: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStorageClass: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectStorageClass](),
+			},
+		},
+	}
+}
+
+func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get(names.AttrBucket).(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	id, err := flex.FlattenResourceId([]string{bucket, keyPrefix}, directoryResourceIDPartCount, true)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := syncDirectory(ctx, d, meta, nil, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDirectoryRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get(names.AttrBucket).(string)
+	conn, optFns := objectConn(ctx, meta, bucket)
+
+	objects, err := findObjectETagsByPrefix(ctx, conn, bucket, sdkv1CompatibleCleanKey(d.Get("key_prefix").(string)), optFns...)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory (%s): %s", d.Id(), err)
+	}
+
+	oldManifest := flex.ExpandStringValueMap(d.Get("manifest").(map[string]interface{}))
+	sourceHashes := flex.ExpandStringValueMap(d.Get("source_hashes").(map[string]interface{}))
+	manifest := make(map[string]string)
+
+	for key, etag := range objects {
+		if _, ok := sourceHashes[key]; ok || d.Get("delete_extraneous").(bool) {
+			manifest[key] = etag
+		}
+	}
+
+	// Objects that have been deleted or changed outside of Terraform are uploaded again.
+	for key := range sourceHashes {
+		if etag, ok := manifest[key]; !ok || (oldManifest[key] != "" && oldManifest[key] != etag) {
+			delete(sourceHashes, key)
+		}
+	}
+
+	d.Set("manifest", manifest)
+	d.Set("source_hashes", sourceHashes)
+
+	return diags
+}
+
+func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Any change to the upload settings applies to every object.
+	all := d.HasChangesExcept("delete_extraneous", "delete_extraneous_without_key_prefix", "manifest", "source_hashes")
+	oldHashesRaw, _ := d.GetChange("source_hashes")
+	oldHashes := flex.ExpandStringValueMap(oldHashesRaw.(map[string]interface{}))
+
+	if err := syncDirectory(ctx, d, meta, oldHashes, all); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectoryRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get(names.AttrBucket).(string)
+	conn, optFns := objectConn(ctx, meta, bucket)
+	keys := tfmaps.Keys(flex.ExpandStringValueMap(d.Get("source_hashes").(map[string]interface{})))
+
+	log.Printf("[INFO] Deleting S3 Directory: %s", d.Id())
+	err := deleteObjectKeys(ctx, conn, bucket, keys, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("key_prefix") {
+		if err := validateDirectoryDeleteExtraneous(d.Get("key_prefix").(string), d.Get("delete_extraneous").(bool), d.Get("delete_extraneous_without_key_prefix").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown(names.AttrSource) || !d.NewValueKnown("exclude") || !d.NewValueKnown("key_prefix") {
+		if err := d.SetNewComputed("source_hashes"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("manifest")
+	}
+
+	files, err := scanDirectory(d.Get(names.AttrSource).(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+
+	if err != nil {
+		return err
+	}
+
+	sourceHashes := make(map[string]string, len(files))
+	for _, file := range files {
+		sourceHashes[file.key] = file.hash
+	}
+
+	oldHashes := flex.ExpandStringValueMap(d.Get("source_hashes").(map[string]interface{}))
+	manifest := flex.ExpandStringValueMap(d.Get("manifest").(map[string]interface{}))
+
+	if !maps.Equal(oldHashes, sourceHashes) {
+		if err := d.SetNew("source_hashes", sourceHashes); err != nil {
+			return err
+		}
+		return d.SetNewComputed("manifest")
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		for key := range manifest {
+			if _, ok := sourceHashes[key]; !ok {
+				return d.SetNewComputed("manifest")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDirectoryDeleteExtraneous returns an error if `delete_extraneous` would delete objects from the whole bucket
+// without that being explicitly acknowledged.
+func validateDirectoryDeleteExtraneous(keyPrefix string, deleteExtraneous, withoutKeyPrefix bool) error {
+	if deleteExtraneous && sdkv1CompatibleCleanKey(keyPrefix) == "" && !withoutKeyPrefix {
+		return errors.New("delete_extraneous without a key_prefix deletes every object in the bucket that isn't uploaded from source; set delete_extraneous_without_key_prefix to confirm")
+	}
+
+	return nil
+}
+
+const directoryResourceIDPartCount = 2
+
+// directoryFile is a file in a source directory.
+type directoryFile struct {
+	// hash is the hex-encoded MD5 hash of the file's content.
+	hash string
+	// key is the object key that the file is uploaded to, cleaned as the AWS SDK for Go v1 did.
+	key string
+	// path is the file's path.
+	path string
+	// relativePath is the file's slash-separated path relative to the source directory.
+	relativePath string
+}
+
+// scanDirectory returns all the regular files in the source directory, other than those matching any exclude pattern.
+func scanDirectory(source, keyPrefix string, excludes []string) ([]directoryFile, error) {
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	var files []directoryFile
+
+	err = filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		relativePath = filepath.ToSlash(relativePath)
+
+		for _, pattern := range excludes {
+			if matchGlob(pattern, relativePath) {
+				return nil
+			}
+		}
+
+		hash, err := fileMD5(filePath)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, directoryFile{
+			hash:         hash,
+			key:          sdkv1CompatibleCleanKey(keyPrefix + relativePath),
+			path:         filePath,
+			relativePath: relativePath,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", source, err)
+	}
+
+	return files, nil
+}
+
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// matchGlob returns whether the slash-separated relative path matches the glob pattern.
+// A pattern without a `/` is matched against the file name only, in any directory.
+func matchGlob(pattern, relativePath string) bool {
+	name := relativePath
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(relativePath)
+	}
+
+	ok, _ := path.Match(pattern, name)
+
+	return ok
+}
+
+func validateGlobPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, v, err))
+	}
+
+	return
+}
+
+// directoryRule sets properties of the objects uploaded from files matching a pattern.
+type directoryRule struct {
+	cacheControl    string
+	contentEncoding string
+	contentType     string
+	metadata        map[string]string
+	pattern         string
+}
+
+func expandDirectoryRules(tfList []interface{}) []directoryRule {
+	var rules []directoryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, directoryRule{
+			cacheControl:    tfMap["cache_control"].(string),
+			contentEncoding: tfMap["content_encoding"].(string),
+			contentType:     tfMap[names.AttrContentType].(string),
+			metadata:        flex.ExpandStringValueMap(tfMap["metadata"].(map[string]interface{})),
+			pattern:         tfMap["pattern"].(string),
+		})
+	}
+
+	return rules
+}
+
+// applyDirectoryRules sets the object properties from every rule that matches the file, in order.
+// The content type is detected from the file extension unless set by a rule.
+func applyDirectoryRules(input *s3.PutObjectInput, file directoryFile, rules []directoryRule, defaultContentType string) {
+	if v := mime.TypeByExtension(path.Ext(file.relativePath)); v != "" {
+		input.ContentType = aws.String(v)
+	} else if defaultContentType != "" {
+		input.ContentType = aws.String(defaultContentType)
+	}
+
+	for _, rule := range rules {
+		if !matchGlob(rule.pattern, file.relativePath) {
+			continue
+		}
+
+		if rule.cacheControl != "" {
+			input.CacheControl = aws.String(rule.cacheControl)
+		}
+
+		if rule.contentEncoding != "" {
+			input.ContentEncoding = aws.String(rule.contentEncoding)
+		}
+
+		if rule.contentType != "" {
+			input.ContentType = aws.String(rule.contentType)
+		}
+
+		if len(rule.metadata) > 0 {
+			if input.Metadata == nil {
+				input.Metadata = make(map[string]string)
+			}
+			maps.Copy(input.Metadata, rule.metadata)
+		}
+	}
+}
+
+// syncDirectory uploads new and changed files in the source directory and deletes the objects of removed files.
+// If `all` is true then every file is uploaded.
+func syncDirectory(ctx context.Context, d *schema.ResourceData, meta interface{}, oldHashes map[string]string, all bool) error {
+	bucket := d.Get(names.AttrBucket).(string)
+	conn, optFns := objectConn(ctx, meta, bucket)
+
+	files, err := scanDirectory(d.Get(names.AttrSource).(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+
+	if err != nil {
+		return err
+	}
+
+	rules := expandDirectoryRules(d.Get(names.AttrRule).([]interface{}))
+	defaultContentType := d.Get("default_content_type").(string)
+
+	var (
+		errs []error
+		mu   sync.Mutex
+		sem  = make(chan struct{}, directoryUploadConcurrency)
+		wg   sync.WaitGroup
+	)
+
+	keys := make(map[string]struct{}, len(files))
+
+	for _, file := range files {
+		keys[file.key] = struct{}{}
+
+		if !all && oldHashes[file.key] == file.hash {
+			continue
+		}
+
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(file.key),
+		}
+
+		if v, ok := d.GetOk("acl"); ok {
+			input.ACL = types.ObjectCannedACL(v.(string))
+		}
+
+		if v, ok := d.GetOk("bucket_key_enabled"); ok {
+			input.BucketKeyEnabled = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("checksum_algorithm"); ok {
+			input.ChecksumAlgorithm = types.ChecksumAlgorithm(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+			input.SSEKMSKeyId = aws.String(v.(string))
+			input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.ServerSideEncryption = types.ServerSideEncryption(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrStorageClass); ok {
+			input.StorageClass = types.StorageClass(v.(string))
+		}
+
+		applyDirectoryRules(input, file, rules, defaultContentType)
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(file directoryFile, input *s3.PutObjectInput) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := uploadFile(ctx, conn, file, input, optFns...)
+
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("uploading %s to S3 Object (%s): %w", file.path, aws.ToString(input.Key), err))
+				mu.Unlock()
+			}
+		}(file, input)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	// Delete the objects of removed files, and with `delete_extraneous` any other objects under the key prefix.
+	var deleteKeys []string
+
+	for key := range oldHashes {
+		if _, ok := keys[key]; !ok {
+			deleteKeys = append(deleteKeys, key)
+		}
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		keyPrefix := d.Get("key_prefix").(string)
+
+		if err := validateDirectoryDeleteExtraneous(keyPrefix, true, d.Get("delete_extraneous_without_key_prefix").(bool)); err != nil {
+			return err
+		}
+
+		objects, err := findObjectETagsByPrefix(ctx, conn, bucket, sdkv1CompatibleCleanKey(keyPrefix), optFns...)
+
+		if err != nil {
+			return err
+		}
+
+		for key := range objects {
+			if _, ok := keys[key]; !ok {
+				if _, ok := oldHashes[key]; !ok {
+					deleteKeys = append(deleteKeys, key)
+				}
+			}
+		}
+	}
+
+	return deleteObjectKeys(ctx, conn, bucket, deleteKeys, optFns...)
+}
+
+func uploadFile(ctx context.Context, conn *s3.Client, file directoryFile, input *s3.PutObjectInput, optFns ...func(*s3.Options)) error {
+	body, err := os.Open(file.path)
+
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	input.Body = body
+
+	_, err = uploadObject(ctx, conn, input, optFns...)
+
+	return err
+}
+
+// findObjectETagsByPrefix returns the ETag of every object whose key starts with the specified prefix.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string, optFns ...func(*s3.Options)) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	objects := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			objects[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return objects, nil
+}
+
+// deleteObjectKeys deletes the current version of the specified objects.
+func deleteObjectKeys(ctx context.Context, conn *s3.Client, bucket string, keys []string, optFns ...func(*s3.Options)) error {
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(keys, deleteObjectsMaxKeys) {
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{
+				Objects: tfslices.ApplyToAll(chunk, func(key string) types.ObjectIdentifier {
+					return types.ObjectIdentifier{
+						Key: aws.String(key),
+					}
+				}),
+				Quiet: aws.Bool(true),
+			},
+		}
+
+		output, err := conn.DeleteObjects(ctx, input, optFns...)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Errors {
+			errs = append(errs, fmt.Errorf("deleting S3 Object (%s): %s: %s", aws.ToString(v.Key), aws.ToString(v.Code), aws.ToString(v.Message)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,527 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestMatchGlob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern      string
+		relativePath string
+		expected     bool
+	}{
+		{
+			pattern:      "*.html",
+			relativePath: "index.html",
+			expected:     true,
+		},
+		{
+			pattern:      "*.html",
+			relativePath: "docs/index.html",
+			expected:     true,
+		},
+		{
+			pattern:      "docs/*.html",
+			relativePath: "docs/index.html",
+			expected:     true,
+		},
+		{
+			pattern:      "docs/*.html",
+			relativePath: "index.html",
+		},
+		{
+			pattern:      "*.css",
+			relativePath: "index.html",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s %s", testCase.pattern, testCase.relativePath), func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.MatchGlob(testCase.pattern, testCase.relativePath), testCase.expected; got != want {
+				t.Errorf("MatchGlob(%q, %q) = %t, want %t", testCase.pattern, testCase.relativePath, got, want)
+			}
+		})
+	}
+}
+
+func TestScanDirectory(t *testing.T) {
+	t.Parallel()
+
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		".git/HEAD":       "ref: refs/heads/main",
+		"docs/notes.tmp":  "notes",
+		"docs/index.html": "<html></html>",
+	})
+
+	got, err := tfs3.ScanDirectoryHashes(source, "site/", []string{".git/*", "*.tmp"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"site/css/site.css":    "fcdce6b6d6e2175f6406869882f6f1ce",
+		"site/docs/index.html": "c83301425b2ad1d496473a5ff3d9ecca",
+		"site/index.html":      "c83301425b2ad1d496473a5ff3d9ecca",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestScanDirectoryCleansKeys(t *testing.T) {
+	t.Parallel()
+
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	testCases := []struct {
+		keyPrefix string
+		expected  string
+	}{
+		{
+			keyPrefix: "",
+			expected:  "index.html",
+		},
+		{
+			keyPrefix: "/",
+			expected:  "index.html",
+		},
+		{
+			keyPrefix: "./site/",
+			expected:  "site/index.html",
+		},
+		{
+			keyPrefix: "/site//www/",
+			expected:  "site/www/index.html",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.keyPrefix, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.ScanDirectoryHashes(source, testCase.keyPrefix, nil)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := map[string]string{
+				testCase.expected: "c83301425b2ad1d496473a5ff3d9ecca",
+			}
+
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateDirectoryDeleteExtraneous(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		keyPrefix        string
+		deleteExtraneous bool
+		withoutKeyPrefix bool
+		expectError      bool
+	}{
+		{
+			keyPrefix: "",
+		},
+		{
+			keyPrefix:        "site/",
+			deleteExtraneous: true,
+		},
+		{
+			keyPrefix:        "",
+			deleteExtraneous: true,
+			expectError:      true,
+		},
+		{
+			keyPrefix:        "/",
+			deleteExtraneous: true,
+			expectError:      true,
+		},
+		{
+			keyPrefix:        "",
+			deleteExtraneous: true,
+			withoutKeyPrefix: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%q %t %t", testCase.keyPrefix, testCase.deleteExtraneous, testCase.withoutKeyPrefix), func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidateDirectoryDeleteExtraneous(testCase.keyPrefix, testCase.deleteExtraneous, testCase.withoutKeyPrefix)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateDirectoryDeleteExtraneous(%q, %t, %t) = %v, want error %t", testCase.keyPrefix, testCase.deleteExtraneous, testCase.withoutKeyPrefix, err, want)
+			}
+		})
+	}
+}
+
+func TestDirectoryObjectInput(t *testing.T) {
+	t.Parallel()
+
+	rules := []interface{}{
+		map[string]interface{}{
+			"cache_control":       "max-age=3600",
+			"content_encoding":    "",
+			names.AttrContentType: "",
+			"metadata":            map[string]interface{}{"site": "example"},
+			"pattern":             "*",
+		},
+		map[string]interface{}{
+			"cache_control":       "no-cache",
+			"content_encoding":    "",
+			names.AttrContentType: "",
+			"metadata":            map[string]interface{}{"page": "true"},
+			"pattern":             "*.html",
+		},
+		map[string]interface{}{
+			"cache_control":       "",
+			"content_encoding":    "gzip",
+			names.AttrContentType: "application/json",
+			"metadata":            map[string]interface{}{},
+			"pattern":             "data/*.gz",
+		},
+	}
+
+	testCases := []struct {
+		relativePath string
+		expected     *s3.PutObjectInput
+	}{
+		{
+			relativePath: "index.html",
+			expected: &s3.PutObjectInput{
+				CacheControl: aws.String("no-cache"),
+				ContentType:  aws.String("text/html; charset=utf-8"),
+				Metadata:     map[string]string{"page": "true", "site": "example"},
+			},
+		},
+		{
+			relativePath: "data/items.gz",
+			expected: &s3.PutObjectInput{
+				CacheControl:    aws.String("max-age=3600"),
+				ContentEncoding: aws.String("gzip"),
+				ContentType:     aws.String("application/json"),
+				Metadata:        map[string]string{"site": "example"},
+			},
+		},
+		{
+			relativePath: "LICENSE",
+			expected: &s3.PutObjectInput{
+				CacheControl: aws.String("max-age=3600"),
+				ContentType:  aws.String("text/plain"),
+				Metadata:     map[string]string{"site": "example"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.relativePath, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfs3.DirectoryObjectInput(testCase.relativePath, rules, "text/plain")
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(s3.PutObjectInput{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccS3Directory_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectExists(ctx, resourceName, "site/index.html"),
+					testAccCheckDirectoryObjectExists(ctx, resourceName, "site/css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "source_hashes.%", acctest.Ct2),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(source, "about.html"), []byte("<html>about</html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectExists(ctx, resourceName, "site/about.html"),
+					testAccCheckDirectoryObjectNotExists(ctx, resourceName, "site/css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "source_hashes.%", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectExists(ctx, resourceName, "site/index.html"),
+					testAccCheckDirectoryPutObject(ctx, resourceName, "site/stale.html"),
+				),
+			},
+			{
+				Config: testAccDirectoryConfig_deleteExtraneous(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectExists(ctx, resourceName, "site/index.html"),
+					testAccCheckDirectoryObjectNotExists(ctx, resourceName, "site/stale.html"),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Directory_deleteExtraneousWithoutKeyPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectoryConfig_deleteExtraneousWithoutKeyPrefix(rName, source),
+				ExpectError: regexache.MustCompile(`set delete_extraneous_without_key_prefix to confirm`),
+			},
+		},
+	})
+}
+
+func testAccDirectoryCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccCheckDirectoryObjectExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		return err
+	}
+}
+
+func testAccCheckDirectoryObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object %s still exists", key)
+	}
+}
+
+func testAccCheckDirectoryPutObject(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+			Body:   strings.NewReader("stale"),
+			Bucket: aws.String(rs.Primary.Attributes[names.AttrBucket]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory" {
+				continue
+			}
+
+			for k := range rs.Primary.Attributes {
+				key, ok := strings.CutPrefix(k, "source_hashes.")
+
+				if !ok || key == "%" {
+					continue
+				}
+
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Object %s still exists", key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+}
+`, rName, source)
+}
+
+func testAccDirectoryConfig_deleteExtraneous(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site/"
+  source            = %[2]q
+  delete_extraneous = true
+}
+`, rName, source)
+}
+
+func testAccDirectoryConfig_deleteExtraneousWithoutKeyPrefix(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  source            = %[2]q
+  delete_extraneous = true
+}
+`, rName, source)
+}
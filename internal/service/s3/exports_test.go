@@ -3,6 +3,10 @@
 
 package s3
 
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceBucketAccelerateConfiguration           = resourceBucketAccelerateConfiguration
@@ -25,6 +29,7 @@ var (
 	ResourceBucketServerSideEncryptionConfiguration = resourceBucketServerSideEncryptionConfiguration
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
+	ResourceDirectory                               = resourceDirectory
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
@@ -56,10 +61,12 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	MatchGlob                             = matchGlob
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 	ValidBucketName                       = validBucketName
+	ValidateDirectoryDeleteExtraneous     = validateDirectoryDeleteExtraneous
 
 	BucketPropagationTimeout       = bucketPropagationTimeout
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
@@ -69,3 +76,27 @@ var (
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
 	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
 )
+
+// ScanDirectoryHashes returns the MD5 hash of each file in the source directory, keyed by object key.
+func ScanDirectoryHashes(source, keyPrefix string, excludes []string) (map[string]string, error) {
+	files, err := scanDirectory(source, keyPrefix, excludes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[string]string, len(files))
+	for _, file := range files {
+		hashes[file.key] = file.hash
+	}
+
+	return hashes, nil
+}
+
+// DirectoryObjectInput returns the PutObject input properties set by the rules for a file.
+func DirectoryObjectInput(relativePath string, tfList []interface{}, defaultContentType string) *s3.PutObjectInput {
+	input := &s3.PutObjectInput{}
+	applyDirectoryRules(input, directoryFile{relativePath: relativePath}, expandDirectoryRules(tfList), defaultContentType)
+
+	return input
+}
@@ -452,17 +452,8 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Client(ctx)
-	var optFns []func(*s3.Options)
-
 	bucket := d.Get(names.AttrBucket).(string)
-	if isDirectoryBucket(bucket) {
-		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
-	}
-	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
-	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
-		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-	}
+	conn, optFns := objectConn(ctx, meta, bucket)
 
 	var body io.ReadSeeker
 
@@ -565,7 +556,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.StorageClass = types.StorageClass(v.(string))
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfigFromContext(ctx)
 	tags := tftags.New(ctx, getContextTags(ctx))
 	if ignoreProviderDefaultTags(ctx, d) {
 		tags = tags.RemoveDefaultConfig(defaultTagsConfig)
@@ -582,15 +573,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	if (input.ObjectLockLegalHoldStatus != "" || input.ObjectLockMode != "" || input.ObjectLockRetainUntilDate != nil) && input.ChecksumAlgorithm == "" {
-		// "Content-MD5 OR x-amz-checksum- HTTP header is required for Put Object requests with Object Lock parameters".
-		// AWS SDK for Go v1 transparently added a Content-MD4 header.
-		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
-	}
-
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
-
-	if _, err := uploader.Upload(ctx, input); err != nil {
+	if _, err := uploadObject(ctx, conn, input, optFns...); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
 	}
 
@@ -601,6 +584,35 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// objectConn returns the S3 client, and any additional client options, to use for objects in the specified bucket.
+func objectConn(ctx context.Context, meta interface{}, bucket string) (*s3.Client, []func(*s3.Options)) {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+// uploadObject uploads an object, using a multipart upload for large objects.
+func uploadObject(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, optFns ...func(*s3.Options)) (*manager.UploadOutput, error) {
+	if (input.ObjectLockLegalHoldStatus != "" || input.ObjectLockMode != "" || input.ObjectLockRetainUntilDate != nil) && input.ChecksumAlgorithm == "" {
+		// "Content-MD5 OR x-amz-checksum- HTTP header is required for Put Object requests with Object Lock parameters".
+		// AWS SDK for Go v1 transparently added a Content-MD4 header.
+		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
+	}
+
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+
+	return uploader.Upload(ctx, input)
+}
+
 func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
 	// Only set non-default KMS key ID (one that doesn't match default).
 	if sseKMSKeyID != "" {
@@ -57,6 +57,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
 		},
+		{
+			Factory:  dataSourceBucketPolicyDocument,
+			TypeName: "aws_s3_bucket_policy_document",
+			Name:     "Bucket Policy Document",
+		},
 		{
 			Factory:  dataSourceObject,
 			TypeName: "aws_s3_object",
@@ -185,6 +190,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_website_configuration",
 			Name:     "Bucket Website Configuration",
 		},
+		{
+			Factory:  resourceDirectory,
+			TypeName: "aws_s3_directory",
+			Name:     "Directory",
+		},
 		{
 			Factory:  resourceObject,
 			TypeName: "aws_s3_object",
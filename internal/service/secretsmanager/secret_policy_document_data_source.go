@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// @SDKDataSource("aws_secretsmanager_secret_policy_document", name="Secret Policy Document")
+func dataSourceSecretPolicyDocument() *schema.Resource {
+	return tfiam.ResourcePolicyDocument{
+		Name:          "Secrets Manager Secret Policy Document",
+		ServicePrefix: "secretsmanager",
+	}.DataSource()
+}
@@ -5,9 +5,13 @@ package secretsmanager
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -16,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -43,11 +48,89 @@ func resourceSecretVersion() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceSecretVersionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"generate": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"secret_binary", "secret_string"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude_characters": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"exclude_lowercase": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"exclude_numbers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"exclude_punctuation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"exclude_uppercase": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"include_space": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"json_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"generate.0.json_template"},
+						},
+						"json_template": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"generate.0.json_key"},
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      32,
+							ValidateFunc: validation.IntBetween(1, 4096),
+						},
+						"require_each_included_type": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"rotate_after": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+						},
+					},
+				},
+			},
+			"rotate_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"secret_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -58,7 +141,7 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_string"},
+				ConflictsWith: []string{"generate", "secret_string"},
 				ValidateFunc:  verify.ValidBase64String,
 			},
 			"secret_string": {
@@ -66,7 +149,11 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_binary"},
+				ConflictsWith: []string{"generate", "secret_binary"},
+			},
+			"secret_string_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"version_id": {
 				Type:     schema.TypeString,
@@ -100,6 +187,23 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	} else if v, ok := d.GetOk("secret_string"); ok {
 		input.SecretString = aws.String(v.(string))
+	} else if v, ok := d.GetOk("generate"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		inputGRP := expandGetRandomPasswordInput(tfMap)
+		outputGRP, err := conn.GetRandomPassword(ctx, inputGRP)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "generating Secrets Manager Secret (%s) value: %s", secretID, err)
+		}
+
+		secretString, err := generatedSecretString(tfMap["json_template"].(string), tfMap["json_key"].(string), aws.ToString(outputGRP.RandomPassword))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "generating Secrets Manager Secret (%s) value: %s", secretID, err)
+		}
+
+		input.SecretString = aws.String(secretString)
 	}
 
 	if v, ok := d.GetOk("version_stages"); ok && v.(*schema.Set).Len() > 0 {
@@ -148,9 +252,27 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set(names.AttrARN, output.ARN)
-	d.Set("secret_binary", itypes.Base64EncodeOnce(output.SecretBinary))
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
+	// Generated values are never stored in state, only their hash.
+	if v, ok := d.GetOk("generate"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		rotateAt := ""
+		if v, ok := tfMap["rotate_after"].(string); ok && v != "" {
+			rotateAfter, err := time.ParseDuration(v)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+			rotateAt = aws.ToTime(output.CreatedDate).Add(rotateAfter).UTC().Format(time.RFC3339)
+		}
+		d.Set("rotate_at", rotateAt)
+		d.Set("secret_string_hash", secretStringHash(aws.ToString(output.SecretString)))
+	} else {
+		d.Set("rotate_at", nil)
+		d.Set("secret_binary", itypes.Base64EncodeOnce(output.SecretBinary))
+		d.Set("secret_string", output.SecretString)
+		d.Set("secret_string_hash", nil)
+	}
 	d.Set("version_id", output.VersionId)
 	d.Set("version_stages", output.VersionStages)
 
@@ -303,6 +425,83 @@ func resourceSecretVersionDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+func resourceSecretVersionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("generate.0.rotate_after") {
+		return d.SetNewComputed("rotate_at")
+	}
+
+	// Once the rotation time has passed, replace the version so that a new value is generated.
+	if v, ok := d.GetOk("rotate_at"); ok && v.(string) != "" {
+		rotateAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return err
+		}
+
+		if time.Now().After(rotateAt) {
+			if err := d.SetNewComputed("rotate_at"); err != nil {
+				return err
+			}
+
+			return d.ForceNew("rotate_at")
+		}
+	}
+
+	return nil
+}
+
+func expandGetRandomPasswordInput(tfMap map[string]interface{}) *secretsmanager.GetRandomPasswordInput {
+	apiObject := &secretsmanager.GetRandomPasswordInput{
+		ExcludeLowercase:        aws.Bool(tfMap["exclude_lowercase"].(bool)),
+		ExcludeNumbers:          aws.Bool(tfMap["exclude_numbers"].(bool)),
+		ExcludePunctuation:      aws.Bool(tfMap["exclude_punctuation"].(bool)),
+		ExcludeUppercase:        aws.Bool(tfMap["exclude_uppercase"].(bool)),
+		IncludeSpace:            aws.Bool(tfMap["include_space"].(bool)),
+		PasswordLength:          aws.Int64(int64(tfMap["length"].(int))),
+		RequireEachIncludedType: aws.Bool(tfMap["require_each_included_type"].(bool)),
+	}
+
+	if v, ok := tfMap["exclude_characters"].(string); ok && v != "" {
+		apiObject.ExcludeCharacters = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// generatedSecretString returns the secret string for a generated value.
+// If a JSON template is specified the value is set as the template's key field.
+func generatedSecretString(template, key, value string) (string, error) {
+	if template == "" {
+		return value, nil
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(template), &m); err != nil {
+		return "", fmt.Errorf("json_template must be a JSON object: %w", err)
+	}
+
+	if m == nil {
+		return "", fmt.Errorf("json_template must be a JSON object")
+	}
+
+	m[key] = value
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func secretStringHash(v string) string {
+	hash := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(hash[:])
+}
+
 const secretVersionIDSeparator = "|"
 
 func secretVersionCreateResourceID(secretID, versionID string) string {
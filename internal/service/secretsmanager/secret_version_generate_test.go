@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"testing"
+)
+
+func TestGeneratedSecretString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		template      string
+		key           string
+		expected      string
+		expectedError bool
+	}{
+		"no template": {
+			expected: "s3cr3t",
+		},
+		"template": {
+			template: `{"username":"admin","port":5432}`,
+			key:      "password",
+			expected: `{"password":"s3cr3t","port":5432,"username":"admin"}`,
+		},
+		"template overwrites key": {
+			template: `{"password":""}`,
+			key:      "password",
+			expected: `{"password":"s3cr3t"}`,
+		},
+		"not an object": {
+			template:      `["password"]`,
+			key:           "password",
+			expectedError: true,
+		},
+		"null": {
+			template:      `null`,
+			key:           "password",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := generatedSecretString(testCase.template, testCase.key, "s3cr3t")
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("generatedSecretString() err %t, want %t: %v", got, want, err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("generatedSecretString() = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestSecretStringHash(t *testing.T) {
+	t.Parallel()
+
+	// echo -n "s3cr3t" | sha256sum
+	if got, want := secretStringHash("s3cr3t"), "4e738ca5563c06cfd0018299933d58db1dd8bf97f6973dc99bf6cdc64b5550bd"; got != want {
+		t.Errorf("secretStringHash() = %q, want %q", got, want)
+	}
+}
@@ -5,6 +5,7 @@ package secretsmanager_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -54,6 +55,42 @@ func TestAccSecretsManagerSecretVersion_basicString(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersion_generate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version secretsmanager.GetSecretValueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionConfig_generate(rName, "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					testAccCheckSecretVersionGeneratedJSON(&version, "password", 40),
+					resource.TestCheckResourceAttr(resourceName, "generate.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "rotate_at"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_string_hash"),
+					resource.TestCheckResourceAttr(resourceName, "version_stages.#", acctest.Ct1),
+				),
+			},
+			{
+				// Changing rotate_after does not generate a new value.
+				Config: testAccSecretVersionConfig_generate(rName, "1440h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					resource.TestCheckResourceAttrPtr(resourceName, "version_id", version.VersionId),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecretVersion_base64Binary(t *testing.T) {
 	ctx := acctest.Context(t)
 	var version secretsmanager.GetSecretValueOutput
@@ -322,6 +359,26 @@ func testAccCheckSecretVersionExists(ctx context.Context, n string, v *secretsma
 	}
 }
 
+func testAccCheckSecretVersionGeneratedJSON(v *secretsmanager.GetSecretValueOutput, key string, length int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var m map[string]string
+
+		if err := json.Unmarshal([]byte(aws.ToString(v.SecretString)), &m); err != nil {
+			return err
+		}
+
+		if got, want := m["username"], "admin"; got != want {
+			return fmt.Errorf("username = %q, want %q", got, want)
+		}
+
+		if got, want := len(m[key]), length; got != want {
+			return fmt.Errorf("len(%s) = %d, want %d", key, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccSecretVersionConfig_string(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
@@ -433,3 +490,23 @@ resource "aws_secretsmanager_secret_version" "test3" {
 }
 `, rName)
 }
+
+func testAccSecretVersionConfig_generate(rName, rotateAfter string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  generate {
+    length              = 40
+    exclude_punctuation = true
+    json_template       = jsonencode({ username = "admin" })
+    json_key            = "password"
+    rotate_after        = %[2]q
+  }
+}
+`, rName, rotateAfter)
+}
@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_secretsmanager_secret",
 			Name:     "Secret",
 		},
+		{
+			Factory:  dataSourceSecretPolicyDocument,
+			TypeName: "aws_secretsmanager_secret_policy_document",
+			Name:     "Secret Policy Document",
+		},
 		{
 			Factory:  dataSourceSecretRotation,
 			TypeName: "aws_secretsmanager_secret_rotation",
@@ -26,6 +26,7 @@ import (
 )
 
 // @FrameworkResource(name="Application")
+// @Sweeper("aws_servicecatalogappregistry_application", list="ListApplications", idField="Id")
 func newResourceApplication(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceApplication{}, nil
 }
@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceTopic,
 			TypeName: "aws_sns_topic",
 		},
+		{
+			Factory:  dataSourceTopicPolicyDocument,
+			TypeName: "aws_sns_topic_policy_document",
+			Name:     "Topic Policy Document",
+		},
 	}
 }
 
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// @SDKDataSource("aws_sns_topic_policy_document", name="Topic Policy Document")
+func dataSourceTopicPolicyDocument() *schema.Resource {
+	return tfiam.ResourcePolicyDocument{
+		Name:          "SNS Topic Policy Document",
+		ServicePrefix: "sns",
+	}.DataSource()
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sns_topic_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "SNS:Publish",
+      "Resource": "arn:aws:sns:us-west-2:123456789012:topic",
+      "Principal": {"Service": "events.amazonaws.com"}
+    }
+  ]
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicPolicyDocumentDataSource_invalidConditionKey(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicPolicyDocumentDataSourceConfig_invalidConditionKey,
+				ExpectError: regexache.MustCompile(`condition key "sqs:QueueUrl" is not a global or sns condition key`),
+			},
+		},
+	})
+}
+
+const testAccTopicPolicyDocumentDataSourceConfig_basic = `
+data "aws_sns_topic_policy_document" "test" {
+  statement {
+    actions   = ["SNS:Publish"]
+    resources = ["arn:aws:sns:us-west-2:123456789012:topic"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["events.amazonaws.com"]
+    }
+  }
+}
+`
+
+const testAccTopicPolicyDocumentDataSourceConfig_invalidConditionKey = `
+data "aws_sns_topic_policy_document" "test" {
+  statement {
+    actions   = ["sns:Subscribe"]
+    resources = ["arn:aws:sns:us-west-2:123456789012:topic"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["123456789012"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "sqs:QueueUrl"
+      values   = ["https://sqs.us-west-2.amazonaws.com/123456789012/queue"]
+    }
+  }
+}
+`
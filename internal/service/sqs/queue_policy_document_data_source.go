@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// @SDKDataSource("aws_sqs_queue_policy_document", name="Queue Policy Document")
+func dataSourceQueuePolicyDocument() *schema.Resource {
+	return tfiam.ResourcePolicyDocument{
+		Name:          "SQS Queue Policy Document",
+		ServicePrefix: "sqs",
+	}.DataSource()
+}
@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceQueue,
 			TypeName: "aws_sqs_queue",
 		},
+		{
+			Factory:  dataSourceQueuePolicyDocument,
+			TypeName: "aws_sqs_queue_policy_document",
+			Name:     "Queue Policy Document",
+		},
 		{
 			Factory:  dataSourceQueues,
 			TypeName: "aws_sqs_queues",
@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// PutParameter has a low default throughput quota, so only a few requests are made at a time.
+	parametersPutConcurrency = 3
+	// DeleteParameters accepts at most 10 names per request.
+	parametersDeleteBatchSize = 10
+
+	// The KMS key used for SecureString parameters when no key is specified.
+	parametersDefaultKeyID = "alias/aws/ssm"
+)
+
+// @SDKResource("aws_ssm_parameters", name="Parameters")
+func resourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceParametersImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delete_unmanaged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_unmanaged_at_root_path": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrParameter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKeyID: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 2047),
+								validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-][0-9A-Za-z_./-]*$`), "must contain only alphanumeric characters, underscores, periods, hyphens and forward slashes, and must not begin with a forward slash"),
+							),
+						},
+						"tier": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.ParameterTierStandard,
+							ValidateDiagFunc: enum.Validate[awstypes.ParameterTier](),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ParameterType](),
+						},
+						names.AttrValue: {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			names.AttrPath: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 2048),
+					validation.StringMatch(regexache.MustCompile(`^/([0-9A-Za-z_.-]+(/[0-9A-Za-z_.-]+)*)?$`), "must begin with a forward slash and must not end with one"),
+				),
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.NewValueKnown(names.AttrPath) {
+				if err := validateParametersDeleteUnmanaged(d.Get(names.AttrPath).(string), d.Get("delete_unmanaged").(bool), d.Get("delete_unmanaged_at_root_path").(bool)); err != nil {
+					return err
+				}
+			}
+
+			seen := make(map[string]struct{})
+
+			for _, tfMapRaw := range d.Get(names.AttrParameter).(*schema.Set).List() {
+				name := tfMapRaw.(map[string]interface{})[names.AttrName].(string)
+
+				// Unknown until apply.
+				if name == "" {
+					continue
+				}
+
+				if _, ok := seen[name]; ok {
+					return fmt.Errorf("parameter %q is specified more than once", name)
+				}
+
+				seen[name] = struct{}{}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Get(names.AttrPath).(string)
+	if err := validateParametersDeleteUnmanaged(path, d.Get("delete_unmanaged").(bool), d.Get("delete_unmanaged_at_root_path").(bool)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	existing, err := findParametersByPath(ctx, conn, path)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+	}
+
+	new := expandPathParameters(d.Get(names.AttrParameter).(*schema.Set).List())
+
+	// Existing parameters that are also configured are only written if they differ.
+	old := make(map[string]pathParameter)
+	for name, v := range existing {
+		if _, ok := new[name]; ok || d.Get("delete_unmanaged").(bool) {
+			old[name] = v
+		}
+	}
+
+	if err := syncParameters(ctx, conn, path, old, new, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+	}
+
+	d.SetId(path)
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	existing, err := findParametersByPath(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	prior := expandPathParameters(d.Get(names.AttrParameter).(*schema.Set).List())
+	deleteUnmanaged := d.Get("delete_unmanaged").(bool)
+
+	var tfList []interface{}
+	for name, v := range existing {
+		p, ok := prior[name]
+
+		// Unmanaged parameters are only tracked when they are to be deleted.
+		if !ok && !deleteUnmanaged {
+			continue
+		}
+
+		tfList = append(tfList, flattenPathParameter(v, p))
+	}
+
+	d.Set(names.AttrParameter, tfList)
+	d.Set(names.AttrPath, d.Id())
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	if d.HasChanges("delete_unmanaged", names.AttrParameter) {
+		o, n := d.GetChange(names.AttrParameter)
+		old, new := expandPathParameters(o.(*schema.Set).List()), expandPathParameters(n.(*schema.Set).List())
+
+		// Unmanaged parameters aren't in state until delete_unmanaged has been applied.
+		if d.Get("delete_unmanaged").(bool) {
+			if err := validateParametersDeleteUnmanaged(d.Id(), true, d.Get("delete_unmanaged_at_root_path").(bool)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			existing, err := findParametersByPath(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", d.Id(), err)
+			}
+
+			for name, v := range existing {
+				if _, ok := old[name]; !ok {
+					old[name] = v
+				}
+			}
+		}
+
+		if err := syncParameters(ctx, conn, d.Id(), old, new, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	old := expandPathParameters(d.Get(names.AttrParameter).(*schema.Set).List())
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", d.Id())
+	if err := syncParameters(ctx, conn, d.Id(), old, nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceParametersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	existing, err := findParametersByPath(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading SSM Parameters (%s): %w", d.Id(), err)
+	}
+
+	// All parameters under the path are managed once imported.
+	var tfList []interface{}
+	for _, v := range existing {
+		tfList = append(tfList, flattenPathParameter(v, pathParameter{}))
+	}
+
+	d.Set("delete_unmanaged", false)
+	d.Set("delete_unmanaged_at_root_path", false)
+	d.Set(names.AttrParameter, tfList)
+	d.Set(names.AttrPath, d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateParametersDeleteUnmanaged returns an error if `delete_unmanaged` would delete parameters from the root path
+// without that being explicitly acknowledged.
+func validateParametersDeleteUnmanaged(path string, deleteUnmanaged, atRootPath bool) error {
+	if deleteUnmanaged && path == "/" && !atRootPath {
+		return errors.New(`delete_unmanaged with path "/" deletes every SSM parameter in the Region that isn't configured in a parameter block; set delete_unmanaged_at_root_path to confirm`)
+	}
+
+	return nil
+}
+
+// pathParameter is a single parameter under a path, keyed by its name relative to the path.
+type pathParameter struct {
+	keyID string
+	name  string
+	tier  awstypes.ParameterTier
+	typ   awstypes.ParameterType
+	value string
+}
+
+func expandPathParameters(tfList []interface{}) map[string]pathParameter {
+	apiObjects := make(map[string]pathParameter, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := pathParameter{
+			keyID: tfMap[names.AttrKeyID].(string),
+			name:  tfMap[names.AttrName].(string),
+			tier:  awstypes.ParameterTier(tfMap["tier"].(string)),
+			typ:   awstypes.ParameterType(tfMap[names.AttrType].(string)),
+			value: tfMap[names.AttrValue].(string),
+		}
+
+		apiObjects[apiObject.name] = apiObject
+	}
+
+	return apiObjects
+}
+
+// flattenPathParameter flattens an existing parameter, keeping the prior values of arguments that AWS resolves.
+func flattenPathParameter(apiObject, prior pathParameter) map[string]interface{} {
+	tier := apiObject.tier
+	if prior.tier == awstypes.ParameterTierIntelligentTiering {
+		tier = prior.tier
+	}
+
+	keyID := apiObject.keyID
+	if apiObject.typ != awstypes.ParameterTypeSecureString || (prior.keyID == "" && keyID == parametersDefaultKeyID) {
+		keyID = ""
+	}
+
+	return map[string]interface{}{
+		names.AttrKeyID: keyID,
+		names.AttrName:  apiObject.name,
+		"tier":          tier,
+		names.AttrType:  apiObject.typ,
+		names.AttrValue: apiObject.value,
+	}
+}
+
+// parametersChanges returns the parameters to be put and the names of the parameters to be deleted
+// to change the parameters under a path from old to new.
+func parametersChanges(old, new map[string]pathParameter) ([]pathParameter, []string) {
+	var puts []pathParameter
+	var deletes []string
+
+	for name, n := range new {
+		if o, ok := old[name]; ok && o == n {
+			continue
+		}
+
+		puts = append(puts, n)
+	}
+
+	for name := range old {
+		if _, ok := new[name]; !ok {
+			deletes = append(deletes, name)
+		}
+	}
+
+	return puts, deletes
+}
+
+// syncParameters makes the minimal PutParameter and DeleteParameters calls needed to change the parameters under a path from old to new.
+func syncParameters(ctx context.Context, conn *ssm.Client, path string, old, new map[string]pathParameter, timeout time.Duration) error {
+	prefix := parametersPrefix(path)
+	puts, deletes := parametersChanges(old, new)
+
+	for _, chunk := range tfslices.Chunks(deletes, parametersDeleteBatchSize) {
+		if err := deleteParameters(ctx, conn, tfslices.ApplyToAll(chunk, func(v string) string {
+			return prefix + v
+		}), timeout); err != nil {
+			return err
+		}
+	}
+
+	var (
+		errs []error
+		mu   sync.Mutex
+		sem  = make(chan struct{}, parametersPutConcurrency)
+		wg   sync.WaitGroup
+	)
+
+	for _, p := range puts {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(p pathParameter) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			name := prefix + p.name
+
+			// You can't downgrade a parameter from the advanced-parameter tier to the standard-parameter tier.
+			var err error
+			if o, ok := old[p.name]; ok && o.tier == awstypes.ParameterTierAdvanced && p.tier == awstypes.ParameterTierStandard {
+				err = deleteParameters(ctx, conn, []string{name}, timeout)
+			}
+
+			if err == nil {
+				err = putParameter(ctx, conn, name, p, timeout)
+			}
+
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("putting SSM Parameter (%s): %w", name, err))
+				mu.Unlock()
+			}
+		}(p)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func putParameter(ctx context.Context, conn *ssm.Client, name string, p pathParameter, timeout time.Duration) error {
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Overwrite: aws.Bool(true),
+		Tier:      p.tier,
+		Type:      p.typ,
+		Value:     aws.String(p.value),
+	}
+
+	if p.keyID != "" && p.typ == awstypes.ParameterTypeSecureString {
+		input.KeyId = aws.String(p.keyID)
+	}
+
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.PutParameter(ctx, input)
+		},
+		parametersRetryable,
+	)
+
+	return err
+}
+
+func deleteParameters(ctx context.Context, conn *ssm.Client, names []string, timeout time.Duration) error {
+	input := &ssm.DeleteParametersInput{
+		Names: names,
+	}
+
+	// Names of parameters that don't exist are returned in InvalidParameters and are ignored.
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.DeleteParameters(ctx, input)
+		},
+		parametersRetryable,
+	)
+
+	if err != nil {
+		return fmt.Errorf("deleting SSM Parameters (%s): %w", strings.Join(names, ", "), err)
+	}
+
+	return nil
+}
+
+// parametersRetryable retries requests that are throttled once the SDK's own retries are exhausted.
+func parametersRetryable(err error) (bool, error) {
+	if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) || errs.IsA[*awstypes.TooManyUpdates](err) {
+		return true, err
+	}
+
+	return false, err
+}
+
+// parametersPrefix returns the prefix of the names of the parameters under a path.
+func parametersPrefix(path string) string {
+	return strings.TrimSuffix(path, "/") + "/"
+}
+
+// findParametersByPath returns the decrypted parameters under a path, recursively, keyed by their names relative to the path.
+func findParametersByPath(ctx context.Context, conn *ssm.Client, path string) (map[string]pathParameter, error) {
+	prefix := parametersPrefix(path)
+	output := make(map[string]pathParameter)
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Parameters {
+			name := strings.TrimPrefix(aws.ToString(v.Name), prefix)
+			output[name] = pathParameter{
+				name:  name,
+				typ:   v.Type,
+				value: aws.ToString(v.Value),
+			}
+		}
+	}
+
+	// The tier and KMS key are only available from the parameters' metadata.
+	if len(output) > 0 {
+		metadata, err := findParametersMetadata(ctx, conn, &ssm.DescribeParametersInput{
+			ParameterFilters: []awstypes.ParameterStringFilter{
+				{
+					Key:    aws.String("Path"),
+					Option: aws.String("Recursive"),
+					Values: []string{path},
+				},
+			},
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range metadata {
+			name := strings.TrimPrefix(aws.ToString(v.Name), prefix)
+
+			if p, ok := output[name]; ok {
+				p.keyID = aws.ToString(v.KeyId)
+				p.tier = v.Tier
+				output[name] = p
+			}
+		}
+	}
+
+	return output, nil
+}
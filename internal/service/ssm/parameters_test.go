@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateParametersDeleteUnmanaged(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		path            string
+		deleteUnmanaged bool
+		atRootPath      bool
+		expectError     bool
+	}{
+		{
+			path: "/",
+		},
+		{
+			path:            "/myapp",
+			deleteUnmanaged: true,
+		},
+		{
+			path:            "/",
+			deleteUnmanaged: true,
+			expectError:     true,
+		},
+		{
+			path:            "/",
+			deleteUnmanaged: true,
+			atRootPath:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%q %t %t", testCase.path, testCase.deleteUnmanaged, testCase.atRootPath), func(t *testing.T) {
+			t.Parallel()
+
+			err := tfssm.ValidateParametersDeleteUnmanaged(testCase.path, testCase.deleteUnmanaged, testCase.atRootPath)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateParametersDeleteUnmanaged(%q, %t, %t) = %v, want error %t", testCase.path, testCase.deleteUnmanaged, testCase.atRootPath, err, want)
+			}
+		})
+	}
+}
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssm_parameters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "delete_unmanaged", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrKeyID: "",
+						names.AttrName:  "database/host",
+						"tier":          "Standard",
+						names.AttrType:  "String",
+						names.AttrValue: "db.example.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "database/password",
+						names.AttrType:  "SecureString",
+						names.AttrValue: "secret",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "hosts",
+						names.AttrType:  "StringList",
+						names.AttrValue: "a,b",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPath, "/"+rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssm_parameters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_count(rName, 25, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 25),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "25"),
+				),
+			},
+			{
+				Config: testAccParametersConfig_count(rName, 15, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 15),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "15"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "param-0",
+						names.AttrValue: "two",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_deleteUnmanaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssm_parameters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_deleteUnmanaged(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersPutUnmanaged(ctx, resourceName, "unmanaged"),
+					testAccCheckParametersCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccParametersConfig_deleteUnmanaged(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "delete_unmanaged", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckParametersCount checks the number of parameters that exist under the resource's path.
+func testAccCheckParametersCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("SSM Parameters %s: got %d parameters, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersPutUnmanaged(ctx context.Context, n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:  aws.String(rs.Primary.ID + "/" + name),
+			Type:  awstypes.ParameterTypeString,
+			Value: aws.String("unmanaged"),
+		})
+
+		return err
+	}
+}
+
+func testAccParametersConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "database/host"
+    type  = "String"
+    value = "db.example.com"
+  }
+
+  parameter {
+    name  = "database/password"
+    type  = "SecureString"
+    value = "secret"
+  }
+
+  parameter {
+    name  = "hosts"
+    type  = "StringList"
+    value = "a,b"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_count(rName string, count int, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  dynamic "parameter" {
+    for_each = range(%[2]d)
+
+    content {
+      name  = "param-${parameter.value}"
+      type  = "String"
+      value = parameter.value < 10 ? %[3]q : "unchanged"
+    }
+  }
+}
+`, rName, count, value)
+}
+
+func testAccParametersConfig_deleteUnmanaged(rName string, deleteUnmanaged bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path             = "/%[1]s"
+  delete_unmanaged = %[2]t
+
+  parameter {
+    name  = "managed"
+    type  = "String"
+    value = "managed"
+  }
+}
+`, rName, deleteUnmanaged)
+}
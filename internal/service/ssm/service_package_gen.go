@@ -113,6 +113,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Parameter",
 			},
 		},
+		{
+			Factory:  resourceParameters,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+		},
 		{
 			Factory:  resourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// sweepers records the sweepers registered via Register and the dependencies between them.
+// The sweepers are added to the test sweeper framework by AddTestSweepers.
+var sweepers = &struct {
+	lock   sync.Mutex
+	graph  *depgraph.Graph
+	byName map[string]*resource.Sweeper
+}{
+	graph:  depgraph.New(),
+	byName: make(map[string]*resource.Sweeper),
+}
+
+func registerSweeper(sweeper *resource.Sweeper, dependsOn ...string) {
+	sweepers.lock.Lock()
+	defer sweepers.lock.Unlock()
+
+	sweepers.byName[sweeper.Name] = sweeper
+	sweepers.graph.AddNode(sweeper.Name)
+
+	for _, v := range dependsOn {
+		sweepers.graph.AddNode(v)
+		// Both nodes exist so no error is returned.
+		_ = sweepers.graph.AddDependency(sweeper.Name, v)
+	}
+}
+
+// AddTestSweepers adds the sweepers registered via Register to the test sweeper framework.
+// Each sweeper's dependencies are all the sweepers that it depends on, directly or transitively, in the order that
+// they run, so that within a single run resources are swept before the resources they depend on, e.g. subnets before VPCs.
+// Returns an error, without adding any sweepers, if a dependency cycle is detected.
+func AddTestSweepers() error {
+	sweepers.lock.Lock()
+	defer sweepers.lock.Unlock()
+
+	dependencies, err := orderedDependencies(sweepers.graph)
+
+	if err != nil {
+		return err
+	}
+
+	for name, sweeper := range sweepers.byName {
+		sweeper.Dependencies = dependencies[name]
+		resource.AddTestSweepers(name, sweeper)
+	}
+
+	clear(sweepers.byName)
+
+	return nil
+}
+
+// orderedDependencies returns, for each node in the dependency graph, the nodes that it depends on,
+// directly or transitively, in the order that they are processed.
+// Returns an error if a dependency cycle is detected.
+func orderedDependencies(graph *depgraph.Graph) (map[string][]string, error) {
+	order, err := graph.OverallOrder()
+
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	dependencies := make(map[string][]string, len(order))
+
+	for _, name := range order {
+		v, err := graph.DependenciesOf(name)
+
+		if err != nil {
+			return nil, fmt.Errorf("ordering sweepers: %w", err)
+		}
+
+		dependencies[name] = v
+	}
+
+	return dependencies, nil
+}
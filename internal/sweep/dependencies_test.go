@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+func TestOrderedDependencies(t *testing.T) {
+	t.Parallel()
+
+	graph := depgraph.New()
+	for _, v := range []string{"aws_subnet", "aws_vpc", "aws_instance", "aws_sqs_queue"} {
+		graph.AddNode(v)
+	}
+	// Subnets are swept before VPCs and instances before subnets.
+	_ = graph.AddDependency("aws_vpc", "aws_subnet")
+	_ = graph.AddDependency("aws_subnet", "aws_instance")
+
+	got, err := orderedDependencies(graph)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string][]string{
+		"aws_instance":  {},
+		"aws_sqs_queue": {},
+		"aws_subnet":    {"aws_instance"},
+		"aws_vpc":       {"aws_instance", "aws_subnet"},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestOrderedDependenciesCycle(t *testing.T) {
+	t.Parallel()
+
+	graph := depgraph.New()
+	graph.AddNode("aws_subnet")
+	graph.AddNode("aws_vpc")
+	_ = graph.AddDependency("aws_vpc", "aws_subnet")
+	_ = graph.AddDependency("aws_subnet", "aws_vpc")
+
+	if _, err := orderedDependencies(graph); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
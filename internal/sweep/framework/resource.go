@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -49,7 +51,7 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, state, err := sr.newState(ctx)
 
 	if err != nil {
 		return err
@@ -58,21 +60,7 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	metadata := resourceMetadata(ctx, resource)
 	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
 
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
-
 	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
@@ -118,6 +106,104 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ImportID returns the resource's import ID, the value of its "id" attribute.
+// Resources identified by other attributes have composite import IDs that can't be derived, so "" is returned.
+func (sr *sweepResource) ImportID(context.Context) string {
+	for _, attr := range sr.attributes {
+		if attr.path == names.AttrID {
+			if v, ok := attr.value.(string); ok {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
+// Names returns the string values of the resource's identifying attributes.
+func (sr *sweepResource) Names(context.Context) []string {
+	var ids []string
+
+	for _, attr := range sr.attributes {
+		if v, ok := attr.value.(string); ok && v != "" {
+			ids = append(ids, v)
+		}
+	}
+
+	return ids
+}
+
+// Tags reads the resource and returns its tags.
+// Tags are nil if the resource no longer exists.
+func (sr *sweepResource) Tags(ctx context.Context) (map[string]string, error) {
+	resource, state, err := sr.newState(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return nil, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := response.State.Schema.GetAttributes()[k]; !ok {
+			continue
+		}
+
+		var tags types.Map
+		if d := response.State.GetAttribute(ctx, path.Root(k), &tags); d.HasError() {
+			return nil, fwdiag.DiagnosticsError(d)
+		}
+
+		if len(tags.Elements()) > 0 {
+			var v map[string]string
+			if d := tags.ElementsAs(ctx, &v, false); d.HasError() {
+				return nil, fwdiag.DiagnosticsError(d)
+			}
+
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// newState returns a configured resource and its state populated with the resource's identifying attributes.
+func (sr *sweepResource) newState(ctx context.Context) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return nil, tfsdk.State{}, err
+	}
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+	}
+
+	return resource, state, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// namer is implemented by Sweepables that can report the identifiers, e.g. ID and name, of the resource to be swept.
+type namer interface {
+	Names(context.Context) []string
+}
+
+// tagger is implemented by Sweepables that can report the tags of the resource to be swept.
+type tagger interface {
+	Tags(context.Context) (map[string]string, error)
+}
+
+// sweepOptions controls which resources sweepers delete.
+type sweepOptions struct {
+	dryRun       bool
+	namePrefixes []string
+	reportFile   string
+	tags         map[string]string
+}
+
+var sweepOptionsFromEnv = sync.OnceValues(func() (*sweepOptions, error) {
+	return newSweepOptions(os.Getenv)
+})
+
+func newSweepOptions(getenv func(string) string) (*sweepOptions, error) {
+	opts := &sweepOptions{
+		reportFile: getenv(envvar.SweepReportFile),
+	}
+
+	if v := getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		opts.dryRun = dryRun
+	}
+
+	if v := getenv(envvar.SweepNamePrefixes); v != "" {
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				opts.namePrefixes = append(opts.namePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := getenv(envvar.SweepTags); v != "" {
+		opts.tags = make(map[string]string)
+		for _, tag := range strings.Split(v, ",") {
+			key, value, ok := strings.Cut(tag, "=")
+			if key = strings.TrimSpace(key); !ok || key == "" {
+				return nil, fmt.Errorf("environment variable %s: invalid tag %q, expected key=value", envvar.SweepTags, tag)
+			}
+			opts.tags[key] = strings.TrimSpace(value)
+		}
+	}
+
+	return opts, nil
+}
+
+// filtered returns whether any name prefix or tag filter is configured.
+func (o *sweepOptions) filtered() bool {
+	return len(o.namePrefixes) > 0 || len(o.tags) > 0
+}
+
+// match returns whether the specified Sweepable passes the configured filters.
+// If it does not, the reason it is skipped is also returned.
+// A Sweepable that cannot report its identifiers or tags never passes the corresponding filter.
+func (o *sweepOptions) match(ctx context.Context, sweepable Sweepable) (bool, string, error) {
+	if len(o.namePrefixes) > 0 {
+		v, ok := sweepable.(namer)
+		if !ok {
+			return false, "resource names are not available", nil
+		}
+
+		if !hasAnyPrefix(v.Names(ctx), o.namePrefixes) {
+			return false, "no name prefix matched", nil
+		}
+	}
+
+	if len(o.tags) > 0 {
+		v, ok := sweepable.(tagger)
+		if !ok {
+			return false, "resource tags are not available", nil
+		}
+
+		tags, err := v.Tags(ctx)
+		if err != nil {
+			return false, "", err
+		}
+
+		for key, value := range o.tags {
+			if v, ok := tags[key]; !ok || v != value {
+				return false, fmt.Sprintf("tag %q does not match", key), nil
+			}
+		}
+	}
+
+	return true, "", nil
+}
+
+func hasAnyPrefix(names, prefixes []string) bool {
+	for _, name := range names {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
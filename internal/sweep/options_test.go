@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	names []string
+	tags  map[string]string
+	err   error
+}
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func (s testSweepable) Names(context.Context) []string {
+	return s.names
+}
+
+func (s testSweepable) Tags(context.Context) (map[string]string, error) {
+	return s.tags, s.err
+}
+
+type opaqueSweepable struct{}
+
+func (opaqueSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func TestNewSweepOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		env           map[string]string
+		expectedDry   bool
+		expectedNames []string
+		expectedTags  map[string]string
+		expectError   bool
+	}{
+		"empty": {},
+		"dry run": {
+			env:         map[string]string{envvar.SweepDryRun: "true"},
+			expectedDry: true,
+		},
+		"invalid dry run": {
+			env:         map[string]string{envvar.SweepDryRun: "maybe"},
+			expectError: true,
+		},
+		"name prefixes": {
+			env:           map[string]string{envvar.SweepNamePrefixes: "tf-acc-test-, ,tf_acc_test_"},
+			expectedNames: []string{"tf-acc-test-", "tf_acc_test_"},
+		},
+		"tags": {
+			env:          map[string]string{envvar.SweepTags: "Owner=ci, Environment = sandbox"},
+			expectedTags: map[string]string{"Environment": "sandbox", "Owner": "ci"},
+		},
+		"invalid tags": {
+			env:         map[string]string{envvar.SweepTags: "Owner"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newSweepOptions(func(k string) string { return testCase.env[k] })
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := got.dryRun, testCase.expectedDry; got != want {
+				t.Errorf("dryRun = %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got.namePrefixes, testCase.expectedNames); diff != "" {
+				t.Errorf("unexpected namePrefixes diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got.tags, testCase.expectedTags); diff != "" {
+				t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweepOptionsMatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		opts        sweepOptions
+		sweepable   Sweepable
+		expected    bool
+		expectError bool
+	}{
+		"no filters": {
+			sweepable: opaqueSweepable{},
+			expected:  true,
+		},
+		"name prefix matches name": {
+			opts:      sweepOptions{namePrefixes: []string{"tf-acc-test-"}},
+			sweepable: testSweepable{names: []string{"i-123456", "tf-acc-test-123"}},
+			expected:  true,
+		},
+		"name prefix does not match": {
+			opts:      sweepOptions{namePrefixes: []string{"tf-acc-test-"}},
+			sweepable: testSweepable{names: []string{"i-123456", "production"}},
+		},
+		"name prefix without names": {
+			opts:      sweepOptions{namePrefixes: []string{"tf-acc-test-"}},
+			sweepable: opaqueSweepable{},
+		},
+		"tags match": {
+			opts:      sweepOptions{tags: map[string]string{"Owner": "ci"}},
+			sweepable: testSweepable{tags: map[string]string{"Name": "test", "Owner": "ci"}},
+			expected:  true,
+		},
+		"tag value does not match": {
+			opts:      sweepOptions{tags: map[string]string{"Owner": "ci"}},
+			sweepable: testSweepable{tags: map[string]string{"Owner": "platform"}},
+		},
+		"tag missing": {
+			opts:      sweepOptions{tags: map[string]string{"Owner": "ci"}},
+			sweepable: testSweepable{},
+		},
+		"tags error": {
+			opts:        sweepOptions{tags: map[string]string{"Owner": "ci"}},
+			sweepable:   testSweepable{err: errors.New("reading")},
+			expectError: true,
+		},
+		"name prefix and tags match": {
+			opts:      sweepOptions{namePrefixes: []string{"tf-acc-test-"}, tags: map[string]string{"Owner": "ci"}},
+			sweepable: testSweepable{names: []string{"tf-acc-test-123"}, tags: map[string]string{"Owner": "ci"}},
+			expected:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _, err := testCase.opts.match(ctx, testCase.sweepable)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	reportStatusFailed     = "failed"
+	reportStatusSkipped    = "skipped"
+	reportStatusSwept      = "swept"
+	reportStatusWouldSweep = "would_sweep"
+)
+
+// reportEntry records the outcome of sweeping a single resource.
+type reportEntry struct {
+	Time         time.Time `json:"time"`
+	Region       string    `json:"region,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	Names        []string  `json:"names,omitempty"`
+	Status       string    `json:"status"`
+	Reason       string    `json:"reason,omitempty"`
+}
+
+// reportLock serializes writes to the report file.
+var reportLock sync.Mutex
+
+// writeReportEntry appends an entry to the report file as a single line of JSON.
+// Entries are written as they occur as the sweeper test binary exits without any cleanup hook.
+func writeReportEntry(ctx context.Context, path string, sweepable Sweepable, status, reason string) error {
+	entry := reportEntry{
+		Time:         time.Now().UTC(),
+		Region:       regionFromContext(ctx),
+		ResourceType: resourceTypeFromContext(ctx),
+		Status:       status,
+		Reason:       reason,
+	}
+
+	if v, ok := sweepable.(namer); ok {
+		entry.Names = v.Names(ctx)
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	reportLock.Lock()
+	defer reportLock.Unlock()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +91,48 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ImportID returns the resource's import ID.
+func (sr *sweepResource) ImportID(context.Context) string {
+	return sr.d.Id()
+}
+
+// Names returns the resource's ID and, if set, its name.
+func (sr *sweepResource) Names(context.Context) []string {
+	ids := []string{sr.d.Id()}
+
+	if _, ok := sr.resource.SchemaMap()[names.AttrName]; ok {
+		if v, ok := sr.d.Get(names.AttrName).(string); ok && v != "" {
+			ids = append(ids, v)
+		}
+	}
+
+	return ids
+}
+
+// Tags reads the resource and returns its tags.
+// Tags are nil if the resource no longer exists.
+func (sr *sweepResource) Tags(ctx context.Context) (map[string]string, error) {
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, nil
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := sr.resource.SchemaMap()[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(map[string]interface{}); ok && len(v) > 0 {
+			return flex.ExpandStringValueMap(v), nil
+		}
+	}
+
+	return nil, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -5,11 +5,14 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -68,24 +71,28 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = append(conf.AssumeRole, assumeRole)
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -104,24 +111,76 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator concurrently deletes the specified resources.
+// The TF_AWS_SWEEP_* environment variables can be used to filter the resources deleted,
+// to list the resources that would be deleted without deleting them and to report the outcome.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	opts, err := sweepOptionsFromEnv()
+	if err != nil {
+		return err
+	}
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			return sweepOne(ctx, opts, sweepable, optFns...)
 		})
 	}
 
 	return g.Wait().ErrorOrNil()
 }
 
+func sweepOne(ctx context.Context, opts *sweepOptions, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if v, ok := sweepable.(namer); ok {
+		ctx = tflog.SetField(ctx, "names", v.Names(ctx))
+	}
+
+	if opts.filtered() {
+		match, reason, err := opts.match(ctx, sweepable)
+
+		if err != nil {
+			return errors.Join(err, report(ctx, opts, sweepable, reportStatusFailed, err.Error()))
+		}
+
+		if !match {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"reason": reason,
+			})
+			return report(ctx, opts, sweepable, reportStatusSkipped, reason)
+		}
+	}
+
+	if opts.dryRun {
+		tflog.Info(ctx, "Skipping resource deletion (dry run)")
+		return report(ctx, opts, sweepable, reportStatusWouldSweep, "")
+	}
+
+	if err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...); err != nil {
+		return errors.Join(err, report(ctx, opts, sweepable, reportStatusFailed, err.Error()))
+	}
+
+	return report(ctx, opts, sweepable, reportStatusSwept, "")
+}
+
+func report(ctx context.Context, opts *sweepOptions, sweepable Sweepable, status, reason string) error {
+	if opts.reportFile == "" {
+		return nil
+	}
+
+	if err := writeReportEntry(ctx, opts.reportFile, sweepable, status, reason); err != nil {
+		return fmt.Errorf("writing sweeper report (%s): %w", opts.reportFile, err)
+	}
+
+	return nil
+}
+
 // Deprecated: Use awsv1.SkipSweepError
 var SkipSweepError = awsv1.SkipSweepError
 
@@ -135,12 +194,16 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
-func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+// Register registers a sweeper, which is added to the test sweeper framework by AddTestSweepers.
+// Dependencies are the names of sweepers that must run before this one, i.e. those sweeping resources that depend on this sweeper's resources.
+func Register(name string, f SweeperFn, dependsOn ...string) {
+	registerLister(name, f)
+
+	registerSweeper(&resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
 			ctx := Context(region)
-			ctx = logWithResourceType(ctx, name)
+			ctx = withResourceType(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
 			if err != nil {
@@ -165,5 +228,31 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 
 			return nil
 		},
-	})
+	}, dependsOn...)
+}
+
+// listers records the sweeper functions registered via Register.
+var listers = &struct {
+	lock sync.Mutex
+	fns  map[string]SweeperFn
+}{
+	fns: make(map[string]SweeperFn),
+}
+
+func registerLister(name string, f SweeperFn) {
+	listers.lock.Lock()
+	defer listers.lock.Unlock()
+
+	listers.fns[name] = f
+}
+
+// Lister returns the sweeper function registered via Register for the specified resource type.
+// The function lists the resources to be swept without deleting them.
+func Lister(name string) (SweeperFn, bool) {
+	listers.lock.Lock()
+	defer listers.lock.Unlock()
+
+	f, ok := listers.fns[name]
+
+	return f, ok
 }
@@ -5,6 +5,8 @@ package sweep_test
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,5 +20,10 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	if err := sweep.AddTestSweepers(); err != nil {
+		fmt.Fprintf(os.Stderr, "adding sweepers: %s\n", err)
+		os.Exit(1)
+	}
+
 	resource.TestMain(m)
 }
@@ -11,8 +11,9 @@ import (
 
 // InContext represents the tagging information kept in Context.
 type InContext struct {
-	DefaultConfig *DefaultConfig
-	IgnoreConfig  *IgnoreConfig
+	DefaultConfig  *DefaultConfig
+	IgnoreConfig   *IgnoreConfig
+	RequiredConfig *RequiredConfig
 	// TagsIn holds tags specified in configuration. Typically this field includes any default tags and excludes system tags.
 	TagsIn option.Option[KeyValueTags]
 	// TagsOut holds tags returned from AWS, including any ignored or system tags.
@@ -20,12 +21,13 @@ type InContext struct {
 }
 
 // NewContext returns a Context enhanced with tagging information.
-func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) context.Context {
+func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, requiredConfig *RequiredConfig) context.Context {
 	v := InContext{
-		DefaultConfig: defaultConfig,
-		IgnoreConfig:  ignoreConfig,
-		TagsIn:        option.None[KeyValueTags](),
-		TagsOut:       option.None[KeyValueTags](),
+		DefaultConfig:  defaultConfig,
+		IgnoreConfig:   ignoreConfig,
+		RequiredConfig: requiredConfig,
+		TagsIn:         option.None[KeyValueTags](),
+		TagsOut:        option.None[KeyValueTags](),
 	}
 
 	return context.WithValue(ctx, tagKey, &v)
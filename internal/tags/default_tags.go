@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"strings"
+)
+
+// DefaultTagsPrecedence determines whether a resource tag or a default tag is used when both have the same key.
+type DefaultTagsPrecedence string
+
+const (
+	// DefaultTagsPrecedenceResource uses the resource tag's value. This is the default.
+	DefaultTagsPrecedenceResource DefaultTagsPrecedence = "resource"
+	// DefaultTagsPrecedenceProvider uses the default tag's value.
+	DefaultTagsPrecedenceProvider DefaultTagsPrecedence = "provider"
+)
+
+func (DefaultTagsPrecedence) Values() []DefaultTagsPrecedence {
+	return []DefaultTagsPrecedence{
+		DefaultTagsPrecedenceResource,
+		DefaultTagsPrecedenceProvider,
+	}
+}
+
+// DefaultTagsOverride adjusts the default tags of the resource types it matches.
+type DefaultTagsOverride struct {
+	// ExcludeKeys are the default tag keys removed from matching resources' default tags.
+	// A trailing `*` matches any key with that prefix.
+	ExcludeKeys []string
+	// ResourceTypes are the resource type names, e.g. `aws_instance`, that the override applies to.
+	// A trailing `*` matches any resource type name with that prefix.
+	ResourceTypes []string
+	// Services are the service package names, e.g. `ec2`, that the override applies to.
+	Services []string
+	// Tags are added to, or replace, matching resources' default tags.
+	Tags KeyValueTags
+}
+
+// appliesTo returns whether the override applies to the specified resource type.
+// An override with no resource types or services applies to all resource types.
+func (o DefaultTagsOverride) appliesTo(servicePackageName, typeName string) bool {
+	if len(o.ResourceTypes) == 0 && len(o.Services) == 0 {
+		return true
+	}
+
+	for _, v := range o.Services {
+		if v == servicePackageName {
+			return true
+		}
+	}
+
+	for _, v := range o.ResourceTypes {
+		if matchPattern(v, typeName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForResource returns the default tags configuration for the specified resource type,
+// with all matching overrides applied in order.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Overrides) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, o := range dc.Overrides {
+		if !o.appliesTo(servicePackageName, typeName) {
+			continue
+		}
+
+		tags = tags.Merge(o.Tags)
+
+		for k := range tags {
+			for _, pattern := range o.ExcludeKeys {
+				if matchPattern(pattern, k) {
+					delete(tags, k)
+					break
+				}
+			}
+		}
+	}
+
+	return &DefaultConfig{
+		Precedence: dc.Precedence,
+		Tags:       tags,
+	}
+}
+
+// DefaultTagsTemplateData contains the values substituted for placeholders in default tag values.
+type DefaultTagsTemplateData struct {
+	AccountID          string
+	Region             string
+	ResourceType       string
+	TerraformWorkspace string
+}
+
+// replacer returns a Replacer for all supported placeholders.
+func (data DefaultTagsTemplateData) replacer() *strings.Replacer {
+	return strings.NewReplacer(
+		"${account_id}", data.AccountID,
+		"${region}", data.Region,
+		"${resource_type}", data.ResourceType,
+		"${terraform_workspace}", data.TerraformWorkspace,
+	)
+}
+
+// Resolve returns the default tags configuration with placeholders, e.g. `${region}`, in tag values
+// replaced by the corresponding values. Unrecognized placeholders are left as-is.
+func (dc *DefaultConfig) Resolve(data DefaultTagsTemplateData) *DefaultConfig {
+	if dc == nil || !dc.Tags.hasPlaceholders() {
+		return dc
+	}
+
+	replacer := data.replacer()
+	tags := make(KeyValueTags, len(dc.Tags))
+
+	for k, v := range dc.Tags {
+		if v == nil || v.Value == nil {
+			tags[k] = v
+			continue
+		}
+
+		value := replacer.Replace(*v.Value)
+		tagData := *v
+		tagData.Value = &value
+		tags[k] = &tagData
+	}
+
+	return &DefaultConfig{
+		Overrides:  dc.Overrides,
+		Precedence: dc.Precedence,
+		Tags:       tags,
+	}
+}
+
+// hasPlaceholders returns whether any tag value may contain a placeholder.
+func (tags KeyValueTags) hasPlaceholders() bool {
+	for _, v := range tags {
+		if v != nil && v.Value != nil && strings.Contains(*v.Value, "${") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// providerPrecedence returns whether default tags take precedence over resource tags.
+func (dc *DefaultConfig) providerPrecedence() bool {
+	return dc != nil && dc.Precedence == DefaultTagsPrecedenceProvider
+}
+
+// matchPattern returns whether s matches pattern, which may end in `*` to match any suffix.
+func matchPattern(pattern, s string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(s, prefix)
+	}
+
+	return pattern == s
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Overrides: []DefaultTagsOverride{
+			{
+				ResourceTypes: []string{"aws_ec2_*", "aws_instance"},
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+				}),
+			},
+			{
+				Services: []string{"iam"},
+				Tags: New(ctx, map[string]string{
+					"Team": "security",
+				}),
+			},
+			{
+				ExcludeKeys:   []string{"Cost*"},
+				ResourceTypes: []string{"aws_iam_role"},
+			},
+		},
+		Precedence: DefaultTagsPrecedenceProvider,
+		Tags: New(ctx, map[string]string{
+			"CostCenter": "1234",
+			"Team":       "platform",
+		}),
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "nil config",
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+		},
+		{
+			name:               "no overrides",
+			defaultConfig:      &DefaultConfig{Tags: New(ctx, map[string]string{"Team": "platform"})},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: map[string]string{
+				"Team": "platform",
+			},
+		},
+		{
+			name:               "no matching override",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Team":       "platform",
+			},
+		},
+		{
+			name:               "resource type prefix",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ec2_host",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Team":       "platform",
+			},
+		},
+		{
+			name:               "resource type",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Team":       "platform",
+			},
+		},
+		{
+			name:               "service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_user",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Team":       "security",
+			},
+		},
+		{
+			name:               "overrides applied in order",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+			want: map[string]string{
+				"Team": "security",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.defaultConfig == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got)
+				}
+				return
+			}
+
+			if got.Precedence != testCase.defaultConfig.Precedence {
+				t.Errorf("got precedence %q, want %q", got.Precedence, testCase.defaultConfig.Precedence)
+			}
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
+
+func TestDefaultConfigResolve(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := DefaultTagsTemplateData{
+		AccountID:          "123456789012",
+		Region:             "us-west-2",
+		ResourceType:       "aws_vpc",
+		TerraformWorkspace: "staging",
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "nil config",
+		},
+		{
+			name: "no placeholders",
+			defaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{
+				"Team": "platform",
+			})},
+			want: map[string]string{
+				"Team": "platform",
+			},
+		},
+		{
+			name: "placeholders",
+			defaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{
+				"Account":   "${account_id}",
+				"Location":  "${region}/${terraform_workspace}",
+				"Team":      "platform",
+				"Type":      "${resource_type}",
+				"Undefined": "${undefined}",
+			})},
+			want: map[string]string{
+				"Account":   "123456789012",
+				"Location":  "us-west-2/staging",
+				"Team":      "platform",
+				"Type":      "aws_vpc",
+				"Undefined": "${undefined}",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.Resolve(data)
+
+			if testCase.defaultConfig == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got)
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
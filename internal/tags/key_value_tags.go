@@ -32,7 +32,11 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	// Overrides adjust Tags for specific resource types. See ForResource.
+	Overrides []DefaultTagsOverride
+	// Precedence determines whether a resource tag or a default tag is used when both have the same key.
+	Precedence DefaultTagsPrecedence
+	Tags       KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -59,11 +63,16 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// If default tags take precedence, the DefaultConfig.Tags values are used instead.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
 	}
 
+	if dc.providerPrecedence() {
+		return tags.Merge(dc.Tags)
+	}
+
 	return dc.Tags.Merge(tags)
 }
 
@@ -770,7 +779,8 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 	for k, v := range configTags {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == v.value {
+				// If default tags take precedence, a resource tag with a different value is also a duplicate.
+				if val, ok := defaultConfig.Tags[k]; ok && (val.ValueString() == v.value || defaultConfig.providerPrecedence()) {
 					// config does not exist during a refresh.
 					// set duplicate values from other sources for refresh diff calculation
 					if !configExists {
@@ -817,7 +827,7 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 					)
 				}
 
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == s {
+				if val, ok := defaultConfig.Tags[k]; ok && (val.ValueString() == s || defaultConfig.providerPrecedence()) {
 					result[k] = s
 				}
 			}
@@ -167,6 +167,25 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "keys some overridden provider precedence",
+			tags: New(ctx, map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
 		{
 			name: "keys none matching",
 			tags: New(ctx, map[string]string{
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RequiredTag is a tag that resources must have.
+type RequiredTag struct {
+	// AllowedValues are the regular expressions, one of which the tag's value must match.
+	// If empty, any value is allowed.
+	AllowedValues []*regexp.Regexp
+	Key           string
+	// ResourceTypes are the resource type names, e.g. `aws_instance`, that must have the tag.
+	// A trailing `*` matches any resource type name with that prefix.
+	// If empty, all taggable resource types must have the tag.
+	ResourceTypes []string
+}
+
+// appliesTo returns whether the specified resource type must have the tag.
+func (rt RequiredTag) appliesTo(typeName string) bool {
+	if len(rt.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, v := range rt.ResourceTypes {
+		if matchPattern(v, typeName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allows returns whether the specified tag value is allowed.
+func (rt RequiredTag) allows(value string) bool {
+	if len(rt.AllowedValues) == 0 {
+		return true
+	}
+
+	for _, re := range rt.AllowedValues {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequiredConfig contains the tags that taggable resources must have.
+type RequiredConfig struct {
+	Tags []RequiredTag
+}
+
+// ForResource returns the required tags configuration for the specified resource type.
+func (rc *RequiredConfig) ForResource(typeName string) *RequiredConfig {
+	if rc == nil {
+		return nil
+	}
+
+	var tags []RequiredTag
+
+	for _, v := range rc.Tags {
+		if v.appliesTo(typeName) {
+			tags = append(tags, v)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &RequiredConfig{
+		Tags: tags,
+	}
+}
+
+// Validate returns an error describing each required tag that is missing from, or has a disallowed value in, the specified tags.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, v := range rc.Tags {
+		tagData, ok := tags[v.Key]
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("required tag %q is missing", v.Key))
+			continue
+		}
+
+		if value := tagData.ValueString(); !v.allows(value) {
+			patterns := make([]string, len(v.AllowedValues))
+			for i, re := range v.AllowedValues {
+				patterns[i] = re.String()
+			}
+			errs = append(errs, fmt.Errorf("required tag %q value %q does not match any of the allowed values (%s)", v.Key, value, strings.Join(patterns, ", ")))
+		}
+	}
+
+	return errors.Join(errs...)
+}
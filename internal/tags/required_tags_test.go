@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+)
+
+func TestRequiredConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	requiredConfig := &RequiredConfig{
+		Tags: []RequiredTag{
+			{
+				Key: "CostCenter",
+			},
+			{
+				AllowedValues: []*regexp.Regexp{
+					regexp.MustCompile(`^(?:dev|prod)$`),
+					regexp.MustCompile(`^(?:test-.*)$`),
+				},
+				Key: "Environment",
+			},
+			{
+				Key:           "Backup",
+				ResourceTypes: []string{"aws_ebs_*", "aws_instance"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		typeName       string
+		tags           map[string]string
+		wantErr        bool
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_vpc",
+		},
+		{
+			name:           "compliant",
+			requiredConfig: requiredConfig,
+			typeName:       "aws_vpc",
+			tags: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test-1",
+			},
+		},
+		{
+			name:           "missing tag",
+			requiredConfig: requiredConfig,
+			typeName:       "aws_vpc",
+			tags: map[string]string{
+				"Environment": "prod",
+			},
+			wantErr: true,
+		},
+		{
+			name:           "disallowed value",
+			requiredConfig: requiredConfig,
+			typeName:       "aws_vpc",
+			tags: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "production",
+			},
+			wantErr: true,
+		},
+		{
+			name:           "missing resource type tag",
+			requiredConfig: requiredConfig,
+			typeName:       "aws_ebs_volume",
+			tags: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "dev",
+			},
+			wantErr: true,
+		},
+		{
+			name:           "resource type tag",
+			requiredConfig: requiredConfig,
+			typeName:       "aws_instance",
+			tags: map[string]string{
+				"Backup":      "daily",
+				"CostCenter":  "1234",
+				"Environment": "dev",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.requiredConfig.ForResource(testCase.typeName).Validate(New(ctx, testCase.tags))
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("got error %v, want error %t", err, want)
+			}
+		})
+	}
+}
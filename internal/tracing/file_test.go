@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestFileClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	client := newFileClient(path)
+
+	if err := client.UploadTraces(ctx, nil); err == nil {
+		t.Fatal("expected error uploading traces before Start")
+	}
+
+	if err := client.Start(ctx); err != nil {
+		t.Fatalf("starting client: %s", err)
+	}
+
+	for _, name := range []string{"span1", "span2"} {
+		spans := []*tracepb.ResourceSpans{{
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Spans: []*tracepb.Span{{Name: name}},
+			}},
+		}}
+
+		if err := client.UploadTraces(ctx, spans); err != nil {
+			t.Fatalf("uploading traces: %s", err)
+		}
+	}
+
+	if err := client.Stop(ctx); err != nil {
+		t.Fatalf("stopping client: %s", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var got []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var request collectortracepb.ExportTraceServiceRequest
+		if err := protojson.Unmarshal(scanner.Bytes(), &request); err != nil {
+			t.Fatalf("decoding line %q: %s", scanner.Text(), err)
+		}
+
+		got = append(got, request.GetResourceSpans()[0].GetScopeSpans()[0].GetSpans()[0].GetName())
+	}
+
+	if len(got) != 2 || got[0] != "span1" || got[1] != "span2" {
+		t.Errorf("spans = %v, want [span1 span2]", got)
+	}
+}
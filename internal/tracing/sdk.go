@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	sdkv1StartSpanHandlerName = "terraform-provider-aws.tracing.StartSpan"
+	sdkv1EndSpanHandlerName   = "terraform-provider-aws.tracing.EndSpan"
+)
+
+// AppendSDKv2Middlewares adds middleware to the AWS SDK for Go v2 configuration
+// that records a span for each AWS API call.
+func AppendSDKv2Middlewares(cfg *aws_sdkv2.Config) {
+	otelaws.AppendMiddlewares(&cfg.APIOptions)
+}
+
+// AddSDKv1Handlers adds handlers to the AWS SDK for Go v1 request handlers
+// that record a span for each AWS API call.
+func AddSDKv1Handlers(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: sdkv1StartSpanHandlerName,
+		Fn:   sdkv1StartSpan,
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: sdkv1EndSpanHandlerName,
+		Fn:   sdkv1EndSpan,
+	})
+}
+
+func sdkv1StartSpan(r *request.Request) {
+	serviceID := r.ClientInfo.ServiceID
+	operation := r.Operation.Name
+
+	ctx, _ := Tracer().Start(r.Context(), fmt.Sprintf("%s.%s", serviceID, operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("aws-api"),
+			semconv.RPCService(serviceID),
+			semconv.RPCMethod(operation),
+			attribute.String("aws.region", aws_sdkv1.StringValue(r.Config.Region)),
+		),
+	)
+
+	r.SetContext(ctx)
+}
+
+func sdkv1EndSpan(r *request.Request) {
+	span := trace.SpanFromContext(r.Context())
+
+	if r.HTTPResponse != nil {
+		span.SetAttributes(semconv.HTTPStatusCode(r.HTTPResponse.StatusCode))
+	}
+	if r.RequestID != "" {
+		span.SetAttributes(attribute.String("aws.request_id", r.RequestID))
+	}
+	if r.Error != nil {
+		span.RecordError(r.Error)
+		span.SetStatus(codes.Error, r.Error.Error())
+	}
+
+	span.End()
+}
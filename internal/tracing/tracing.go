@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Environment variables used to enable and configure tracing.
+const (
+	// EnvVarTracesExporter selects the exporter used for traces.
+	// Valid values are "otlp", which exports to an OpenTelemetry collector configured using the
+	// standard OTEL_EXPORTER_OTLP_* environment variables, and "file".
+	// Tracing is disabled if the variable is unset.
+	EnvVarTracesExporter = "TF_AWS_OTEL_TRACES_EXPORTER"
+
+	// EnvVarTracesFile is the path of the file that traces are appended to when the "file" exporter is selected.
+	EnvVarTracesFile = "TF_AWS_OTEL_TRACES_FILE"
+)
+
+const (
+	ExporterFile = "file"
+	ExporterOTLP = "otlp"
+)
+
+const (
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+	serviceName         = "terraform-provider-aws"
+)
+
+// Attribute keys recorded on resource lifecycle spans.
+const (
+	AttrKeyOperation      = attribute.Key("tf.operation")
+	AttrKeyResourceID     = attribute.Key("tf.resource.id")
+	AttrKeyResourceType   = attribute.Key("tf.resource.type")
+	AttrKeyServicePackage = attribute.Key("tf.service_package")
+)
+
+// Enabled returns whether tracing has been enabled via environment variable.
+func Enabled() bool {
+	return os.Getenv(EnvVarTracesExporter) != ""
+}
+
+// Configure installs a global OpenTelemetry tracer provider if tracing is enabled.
+// The returned function flushes any pending spans and must be called before the provider exits.
+func Configure(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if !Enabled() {
+		return noop, nil
+	}
+
+	var client otlptrace.Client
+	var newSpanProcessor func(sdktrace.SpanExporter) sdktrace.TracerProviderOption
+	switch v := os.Getenv(EnvVarTracesExporter); v {
+	case ExporterFile:
+		path := os.Getenv(EnvVarTracesFile)
+		if path == "" {
+			return noop, fmt.Errorf("%s must be set when %s is %q", EnvVarTracesFile, EnvVarTracesExporter, v)
+		}
+		client = newFileClient(path)
+		// Write spans as they end so that none are lost if the provider process is killed.
+		newSpanProcessor = func(exporter sdktrace.SpanExporter) sdktrace.TracerProviderOption {
+			return sdktrace.WithSyncer(exporter)
+		}
+	case ExporterOTLP:
+		client = otlptracehttp.NewClient()
+		newSpanProcessor = func(exporter sdktrace.SpanExporter) sdktrace.TracerProviderOption {
+			return sdktrace.WithBatcher(exporter)
+		}
+	default:
+		return noop, fmt.Errorf("unsupported %s value: %q", EnvVarTracesExporter, v)
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return noop, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.ProviderVersion),
+	))
+	if err != nil {
+		return noop, fmt.Errorf("creating OpenTelemetry resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		newSpanProcessor(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the provider's tracer.
+// If tracing has not been configured, a no-op tracer is returned.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName, trace.WithInstrumentationVersion(version.ProviderVersion))
+}
+
+// StartResourceSpan starts a span for a resource or data source lifecycle operation.
+func StartResourceSpan(ctx context.Context, servicePackageName, typeName, operation string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, fmt.Sprintf("%s %s", typeName, operation),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			AttrKeyOperation.String(operation),
+			AttrKeyResourceType.String(typeName),
+			AttrKeyServicePackage.String(servicePackageName),
+		),
+	)
+}
+
+// EndSpan ends the span, recording the resource's ID and any error.
+func EndSpan(span trace.Span, id string, err error) {
+	if id != "" {
+		span.SetAttributes(AttrKeyResourceID.String(id))
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
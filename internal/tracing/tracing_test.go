@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestResourceSpan(t *testing.T) { //nolint:paralleltest // Sets the global tracer provider
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx := context.Background()
+
+	_, span := StartResourceSpan(ctx, "s3", "aws_s3_bucket", "Create")
+	EndSpan(span, "my-bucket", nil)
+
+	_, span = StartResourceSpan(ctx, "s3", "aws_s3_bucket", "Delete")
+	EndSpan(span, "", errors.New("deleting S3 Bucket"))
+
+	spans := recorder.Ended()
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("length of ended spans = %d, want %d", got, want)
+	}
+
+	if got, want := spans[0].Name(), "aws_s3_bucket Create"; got != want {
+		t.Errorf("span name = %q, want %q", got, want)
+	}
+	if got, want := spans[0].Status().Code, codes.Unset; got != want {
+		t.Errorf("span status = %v, want %v", got, want)
+	}
+
+	attrs := make(map[string]string)
+	for _, v := range spans[0].Attributes() {
+		attrs[string(v.Key)] = v.Value.Emit()
+	}
+	for k, want := range map[string]string{
+		string(AttrKeyOperation):      "Create",
+		string(AttrKeyResourceID):     "my-bucket",
+		string(AttrKeyResourceType):   "aws_s3_bucket",
+		string(AttrKeyServicePackage): "s3",
+	} {
+		if got := attrs[k]; got != want {
+			t.Errorf("span attribute %s = %q, want %q", k, got, want)
+		}
+	}
+
+	if got, want := spans[1].Status().Code, codes.Error; got != want {
+		t.Errorf("span status = %v, want %v", got, want)
+	}
+	if got, want := spans[1].Status().Description, "deleting S3 Bucket"; got != want {
+		t.Errorf("span status description = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	// redacted replaces sensitive values in recorded interactions.
+	redacted = "REDACTED"
+)
+
+var (
+	// sensitiveHeaders are the HTTP request headers removed from recorded interactions.
+	sensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// sensitiveOperations are the AWS API operations whose responses contain credentials or secret values,
+	// with the function that redacts those values from a recorded response body.
+	// Operations are identified as returned by operationName.
+	sensitiveOperations = map[string]func(string) string{
+		// AWS credentials.
+		"AssumeRole":                      redactXMLFields("AccessKeyId", "SecretAccessKey", "SessionToken"),
+		"AssumeRoleWithSAML":              redactXMLFields("AccessKeyId", "SecretAccessKey", "SessionToken"),
+		"AssumeRoleWithWebIdentity":       redactXMLFields("AccessKeyId", "SecretAccessKey", "SessionToken"),
+		"GetFederationToken":              redactXMLFields("AccessKeyId", "SecretAccessKey", "SessionToken"),
+		"GetSessionToken":                 redactXMLFields("AccessKeyId", "SecretAccessKey", "SessionToken"),
+		"CreateAccessKey":                 redactXMLFields("SecretAccessKey"),
+		"GetRoleCredentials":              redactJSONFields("accessKeyId", "secretAccessKey", "sessionToken"),
+		"CreateServiceSpecificCredential": redactXMLFields("ServicePassword"),
+		"ResetServiceSpecificCredential":  redactXMLFields("ServicePassword"),
+		// Secrets Manager secret values.
+		"secretsmanager.BatchGetSecretValue": redactJSONFields("SecretBinary", "SecretString"),
+		"secretsmanager.GetRandomPassword":   redactJSONFields("RandomPassword"),
+		"secretsmanager.GetSecretValue":      redactJSONFields("SecretBinary", "SecretString"),
+		// SSM SecureString parameter values.
+		"AmazonSSM.GetParameter":        redactSecureStringParameterValues,
+		"AmazonSSM.GetParameterHistory": redactSecureStringParameterValues,
+		"AmazonSSM.GetParameters":       redactSecureStringParameterValues,
+		"AmazonSSM.GetParametersByPath": redactSecureStringParameterValues,
+		// KMS plaintext data keys.
+		"TrentService.Decrypt":             redactJSONFields("Plaintext"),
+		"TrentService.GenerateDataKey":     redactJSONFields("Plaintext"),
+		"TrentService.GenerateDataKeyPair": redactJSONFields("PrivateKeyPlaintext"),
+	}
+)
+
+// NewRecorder returns a VCR recorder for AWS API traffic using the specified cassette.
+// Sensitive HTTP headers are removed from recorded interactions and requests are matched
+// to recorded interactions by method, URL and semantically equal body.
+func NewRecorder(ctx context.Context, cassetteName string, mode recorder.Mode, realTransport http.RoundTripper) (*recorder.Recorder, error) {
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  cassetteName,
+		Mode:          mode,
+		RealTransport: realTransport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sensitive HTTP headers.
+	// Redaction happens just before the cassette is saved so that live requests and responses are unchanged.
+	r.AddHook(redactHeaders, recorder.BeforeSaveHook)
+
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(matcher(ctx))
+
+	return r, nil
+}
+
+func redactHeaders(i *cassette.Interaction) error {
+	for _, v := range sensitiveHeaders {
+		delete(i.Request.Headers, v)
+	}
+
+	return nil
+}
+
+func redactResponseBody(i *cassette.Interaction) error {
+	if f, ok := sensitiveOperations[operationName(i.Request)]; ok {
+		i.Response.Body = f(i.Response.Body)
+	}
+
+	return nil
+}
+
+// operationName returns the name of the AWS API operation of a recorded request.
+// It is the X-Amz-Target header for the AWS JSON protocols, e.g. "secretsmanager.GetSecretValue",
+// and the Action parameter for the AWS Query protocol, e.g. "AssumeRole".
+func operationName(r cassette.Request) string {
+	if v := r.Headers.Get("X-Amz-Target"); v != "" {
+		return v
+	}
+
+	if v := r.Form.Get("Action"); v != "" {
+		return v
+	}
+
+	if v, err := url.ParseQuery(r.Body); err == nil && v.Get("Action") != "" {
+		return v.Get("Action")
+	}
+
+	// AWS IAM Identity Center (SSO) uses the REST-JSON protocol.
+	if u, err := url.Parse(r.URL); err == nil && u.Path == "/federation/credentials" {
+		return "GetRoleCredentials"
+	}
+
+	return ""
+}
+
+// redactXMLFields returns a function that redacts the values of the specified XML elements.
+func redactXMLFields(fields ...string) func(string) string {
+	// Go regular expressions don't support backreferences, so there is one per element.
+	regexps := make([]*regexp.Regexp, 0, len(fields))
+	for _, field := range fields {
+		regexps = append(regexps, regexache.MustCompile(`<`+field+`>[^<]*</`+field+`>`))
+	}
+
+	return func(body string) string {
+		for i, re := range regexps {
+			body = re.ReplaceAllLiteralString(body, `<`+fields[i]+`>`+redacted+`</`+fields[i]+`>`)
+		}
+
+		return body
+	}
+}
+
+// redactJSONFields returns a function that redacts the string values of the specified JSON fields.
+func redactJSONFields(fields ...string) func(string) string {
+	re := regexache.MustCompile(`"(` + strings.Join(fields, "|") + `)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	return func(body string) string {
+		return re.ReplaceAllString(body, `"$1"$2"`+redacted+`"`)
+	}
+}
+
+// redactSecureStringParameterValues redacts the values of SSM SecureString parameters.
+// The values of String and StringList parameters are not secret and are left as-is.
+func redactSecureStringParameterValues(body string) string {
+	d := json.NewDecoder(strings.NewReader(body))
+	d.UseNumber()
+
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return body
+	}
+
+	if !redactSecureStringParameterValue(v) {
+		return body
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+
+	return string(b)
+}
+
+// redactSecureStringParameterValue redacts the values of any SSM SecureString parameters in v,
+// returning whether any value was redacted.
+func redactSecureStringParameterValue(v any) bool {
+	var ok bool
+
+	switch v := v.(type) {
+	case map[string]any:
+		if v["Type"] == "SecureString" {
+			if _, exists := v["Value"]; exists {
+				v["Value"] = redacted
+				ok = true
+			}
+		}
+		for _, child := range v {
+			ok = redactSecureStringParameterValue(child) || ok
+		}
+	case []any:
+		for _, child := range v {
+			ok = redactSecureStringParameterValue(child) || ok
+		}
+	}
+
+	return ok
+}
+
+func matcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		// Default matcher compares method and URL only.
+		if !cassette.DefaultMatcher(r, i) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType := r.Header.Get("Content-Type"); contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	}
+}
+
+// recorders are the recorders opened for provider-level recording and replay.
+// They are stopped, saving any recorded cassettes, when the provider shuts down.
+var recorders = &struct {
+	lock  sync.Mutex
+	store []*recorder.Recorder
+}{}
+
+// NewHTTPClient returns an HTTP client that records all AWS API traffic to, or replays it from,
+// the specified cassette file. The returned client wraps the specified HTTP client's transport.
+// Credentials and secret values are also redacted from recorded response bodies.
+// The cassette is saved when StopRecorders is called.
+func NewHTTPClient(ctx context.Context, httpClient *http.Client, cassetteFile string, mode recorder.Mode) (*http.Client, error) {
+	// The cassette file name always has a ".yaml" extension.
+	r, err := NewRecorder(ctx, strings.TrimSuffix(cassetteFile, ".yaml"), mode, httpClient.Transport)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening VCR cassette (%s): %w", cassetteFile, err)
+	}
+
+	r.AddHook(redactResponseBody, recorder.BeforeSaveHook)
+
+	recorders.lock.Lock()
+	defer recorders.lock.Unlock()
+
+	recorders.store = append(recorders.store, r)
+
+	client := *httpClient
+	client.Transport = r
+
+	return &client, nil
+}
+
+// StopRecorders stops all recorders opened with NewHTTPClient, saving any recorded cassettes.
+func StopRecorders() error {
+	recorders.lock.Lock()
+	defer recorders.lock.Unlock()
+
+	var errs []error
+
+	for _, r := range recorders.store {
+		if err := r.Stop(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	recorders.store = nil
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+func TestRedactResponseBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  cassette.Request
+		body     string
+		expected string
+	}{
+		"empty": {
+			request: cassette.Request{Headers: http.Header{"X-Amz-Target": {"secretsmanager.GetSecretValue"}}},
+		},
+		"other operation": {
+			request:  cassette.Request{Headers: http.Header{"X-Amz-Target": {"secretsmanager.DescribeSecret"}}},
+			body:     `{"Name":"test","SecretString":"s3cr3t"}`,
+			expected: `{"Name":"test","SecretString":"s3cr3t"}`,
+		},
+		"tag values": {
+			request:  cassette.Request{Headers: http.Header{"X-Amz-Target": {"AmazonSSM.ListTagsForResource"}}},
+			body:     `{"TagList":[{"Key":"Name","Value":"test"}]}`,
+			expected: `{"TagList":[{"Key":"Name","Value":"test"}]}`,
+		},
+		"JSON secret": {
+			request:  cassette.Request{Headers: http.Header{"X-Amz-Target": {"secretsmanager.GetSecretValue"}}},
+			body:     `{"ARN":"arn","SecretString": "s3cr3t \"quoted\"","Name":"test"}`,
+			expected: `{"ARN":"arn","SecretString": "REDACTED","Name":"test"}`,
+		},
+		"SSM String parameter": {
+			request:  cassette.Request{Headers: http.Header{"X-Amz-Target": {"AmazonSSM.GetParameter"}}},
+			body:     `{"Parameter":{"Name":"test","Type":"String","Value":"value"}}`,
+			expected: `{"Parameter":{"Name":"test","Type":"String","Value":"value"}}`,
+		},
+		"SSM SecureString parameters": {
+			request:  cassette.Request{Headers: http.Header{"X-Amz-Target": {"AmazonSSM.GetParameters"}}},
+			body:     `{"InvalidParameters":[],"Parameters":[{"Name":"one","Type":"String","Value":"value","Version":1},{"Name":"two","Type":"SecureString","Value":"s3cr3t","Version":2}]}`,
+			expected: `{"InvalidParameters":[],"Parameters":[{"Name":"one","Type":"String","Value":"value","Version":1},{"Name":"two","Type":"SecureString","Value":"REDACTED","Version":2}]}`,
+		},
+		"JSON credentials": {
+			request:  cassette.Request{URL: "https://portal.sso.us-west-2.amazonaws.com/federation/credentials?account_id=123456789012&role_name=test"}, //lintignore:AWSAT003
+			body:     `{"roleCredentials":{"accessKeyId":"AKIA","secretAccessKey":"secret","sessionToken":"token"}}`,
+			expected: `{"roleCredentials":{"accessKeyId":"REDACTED","secretAccessKey":"REDACTED","sessionToken":"REDACTED"}}`,
+		},
+		"XML credentials": {
+			request:  cassette.Request{Body: "Action=AssumeRole&RoleArn=arn&Version=2011-06-15"},
+			body:     `<Credentials><AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials>`,
+			expected: `<Credentials><AccessKeyId>REDACTED</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`,
+		},
+		"XML access key": {
+			request:  cassette.Request{Form: url.Values{"Action": {"CreateAccessKey"}}},
+			body:     `<AccessKey><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey></AccessKey>`,
+			expected: `<AccessKey><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey></AccessKey>`,
+		},
+		"XML mismatched elements": {
+			request:  cassette.Request{Body: "Action=AssumeRole&RoleArn=arn&Version=2011-06-15"},
+			body:     `<SecretAccessKey>secret</SessionToken>`,
+			expected: `<SecretAccessKey>secret</SessionToken>`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			i := &cassette.Interaction{
+				Request:  testCase.request,
+				Response: cassette.Response{Body: testCase.body},
+			}
+
+			if err := redactResponseBody(i); err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := i.Response.Body, testCase.expected; got != want {
+				t.Errorf("redactResponseBody(%q) = %q, want %q", testCase.body, got, want)
+			}
+		})
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		method      string
+		url         string
+		contentType string
+		body        string
+		recorded    cassette.Request
+		expected    bool
+	}{
+		"identical body": {
+			method:   http.MethodPost,
+			url:      "https://sts.amazonaws.com/",
+			body:     "Action=GetCallerIdentity&Version=2011-06-15",
+			recorded: cassette.Request{Method: http.MethodPost, URL: "https://sts.amazonaws.com/", Body: "Action=GetCallerIdentity&Version=2011-06-15"},
+			expected: true,
+		},
+		"different method": {
+			method:   http.MethodGet,
+			url:      "https://sts.amazonaws.com/",
+			recorded: cassette.Request{Method: http.MethodPost, URL: "https://sts.amazonaws.com/"},
+		},
+		"different URL": {
+			method:   http.MethodGet,
+			url:      "https://route53.amazonaws.com/2013-04-01/hostedzone/Z1",
+			recorded: cassette.Request{Method: http.MethodGet, URL: "https://route53.amazonaws.com/2013-04-01/hostedzone/Z2"},
+		},
+		"reordered JSON body": {
+			method:      http.MethodPost,
+			url:         "https://logs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"logGroupNamePrefix":"test","limit":50}`,
+			recorded:    cassette.Request{Method: http.MethodPost, URL: "https://logs.us-west-2.amazonaws.com/", Body: `{"limit":50,"logGroupNamePrefix":"test"}`},
+			expected:    true,
+		},
+		"different JSON body": {
+			method:      http.MethodPost,
+			url:         "https://logs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"logGroupNamePrefix":"test"}`,
+			recorded:    cassette.Request{Method: http.MethodPost, URL: "https://logs.us-west-2.amazonaws.com/", Body: `{"logGroupNamePrefix":"other"}`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequestWithContext(ctx, testCase.method, testCase.url, strings.NewReader(testCase.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if testCase.contentType != "" {
+				r.Header.Set("Content-Type", testCase.contentType)
+			}
+
+			if got, want := matcher(ctx)(r, testCase.recorded), testCase.expected; got != want {
+				t.Errorf("matcher = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientRedaction(t *testing.T) { //nolint:paralleltest // StopRecorders stops all recorders
+	ctx := context.Background()
+
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleValidEndpoint,
+	})
+	defer ts.Close()
+
+	cassetteFile := filepath.Join(t.TempDir(), "sts.yaml")
+	client, err := NewHTTPClient(ctx, &http.Client{Transport: http.DefaultTransport}, cassetteFile, recorder.ModeRecordOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL, strings.NewReader(servicemocks.MockStsAssumeRoleValidEndpoint.Request.Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=StaticAccessKey")
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := client.Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The caller receives the real credentials.
+	for _, v := range []string{servicemocks.MockStsAssumeRoleAccessKey, servicemocks.MockStsAssumeRoleSecretKey, servicemocks.MockStsAssumeRoleSessionToken} {
+		if !strings.Contains(string(body), v) {
+			t.Errorf("response body does not contain %q: %s", v, body)
+		}
+	}
+
+	if err := StopRecorders(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(cassetteFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := string(b)
+
+	// The saved cassette holds only redacted credentials.
+	for _, v := range []string{servicemocks.MockStsAssumeRoleAccessKey, servicemocks.MockStsAssumeRoleSecretKey, servicemocks.MockStsAssumeRoleSessionToken, "StaticAccessKey"} {
+		if strings.Contains(saved, v) {
+			t.Errorf("saved cassette contains %q", v)
+		}
+	}
+	if !strings.Contains(saved, "<SecretAccessKey>"+redacted+"</SecretAccessKey>") {
+		t.Errorf("saved cassette does not contain redacted credentials: %s", saved)
+	}
+}
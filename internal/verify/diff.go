@@ -24,6 +24,13 @@ import (
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	var requiredTagsConfig *tftags.RequiredConfig
+
+	// Prefer any default tags scoped to the resource type.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+		requiredTagsConfig = inContext.RequiredConfig
+	}
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
@@ -42,6 +49,10 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	if err := requiredTagsConfig.Validate(allTags); err != nil {
+		return fmt.Errorf("tags_all does not comply with the provider's required_tags: %w", err)
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
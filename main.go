@@ -10,13 +10,23 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 func main() {
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	ctx := context.Background()
+
+	shutdownTracing, err := tracing.Configure(ctx)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 	if err != nil {
 		log.Fatal(err)
@@ -38,6 +48,14 @@ func main() {
 		serveOpts...,
 	)
 
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] shutting down tracing: %s", err)
+	}
+
+	if err := vcr.StopRecorders(); err != nil {
+		log.Printf("[WARN] saving AWS API traffic recording: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -175,6 +175,10 @@ func (sr ServiceRecord) EndpointOverrideRegion() string {
 	return sr[colEndpointOverrideRegion]
 }
 
+func (sr ServiceRecord) Global() bool {
+	return sr[colGlobal] != ""
+}
+
 func (sr ServiceRecord) Note() string {
 	return sr[colNote]
 }
@@ -236,5 +240,6 @@ const (
 	colEndpointAPICall   // API call to use for endpoint tests
 	colEndpointAPIParams // Any needed parameters for endpoint tests
 	colEndpointOverrideRegion
+	colGlobal // If set, the service's resources are not Region-scoped
 	colNote
 )
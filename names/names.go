@@ -253,8 +253,12 @@ type ServiceDatum struct {
 // serviceData key is the AWS provider service package
 var serviceData map[string]*ServiceDatum
 
+// globalServices are the AWS provider service packages whose resources are not Region-scoped
+var globalServices map[string]struct{}
+
 func init() {
 	serviceData = make(map[string]*ServiceDatum)
+	globalServices = make(map[string]struct{})
 
 	// Data from names_data.csv
 	if err := readCSVIntoServiceData(); err != nil {
@@ -272,6 +276,11 @@ func readCSVIntoServiceData() error {
 	}
 
 	for _, l := range d {
+		// Excluded pseudo-services, e.g. "meta", can also be global.
+		if l.Global() {
+			globalServices[l.ProviderPackage()] = struct{}{}
+		}
+
 		if l.Exclude() {
 			continue
 		}
@@ -429,6 +438,13 @@ func SDKID(service string) string {
 	return ""
 }
 
+// IsGlobal returns whether the service's resources are not Region-scoped
+func IsGlobal(service string) bool {
+	_, ok := globalServices[service]
+
+	return ok
+}
+
 func ClientSDKV1(service string) bool {
 	if v, ok := serviceData[service]; ok {
 		return v.ClientSDKV1
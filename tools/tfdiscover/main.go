@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/discover"
+)
+
+var (
+	endpoints = flag.String("endpoints", "", "Comma-separated list of service=URL custom endpoints, e.g. sqs=http://localhost:4566")
+	output    = flag.String("output", "", "File to write import blocks to (default standard output)")
+	profile   = flag.String("profile", "", "AWS shared configuration profile")
+	regions   = flag.String("regions", "", "Comma-separated list of AWS Regions to discover resources in (default the configured Region); the first is the provider's Region")
+	services  = flag.String("services", "", "Comma-separated list of service packages to discover resources of (default all)")
+	tags      = flag.String("tags", "", "Comma-separated list of key=value tags that discovered resources must have")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\ttfdiscover [-services <services>] [-regions <regions>] [-tags <tags>] [-profile <profile>] [-endpoints <endpoints>] [-output <file>]\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if len(flag.Args()) > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	endpoints, err := parseKeyValues(*endpoints)
+	if err != nil {
+		return fmt.Errorf("parsing -endpoints: %w", err)
+	}
+
+	tags, err := parseKeyValues(*tags)
+	if err != nil {
+		return fmt.Errorf("parsing -tags: %w", err)
+	}
+
+	regions := splitList(*regions)
+	if len(regions) == 0 {
+		// Use the Region from the environment or shared configuration.
+		regions = []string{""}
+	}
+
+	var clients []*conns.AWSClient
+	for _, region := range regions {
+		config := map[string]any{}
+
+		if region != "" {
+			config["region"] = region
+		}
+
+		if *profile != "" {
+			config["profile"] = *profile
+		}
+
+		if len(endpoints) > 0 {
+			m := make(map[string]any, len(endpoints))
+			for k, v := range endpoints {
+				m[k] = v
+			}
+			config["endpoints"] = []any{m}
+		}
+
+		client, err := discover.NewClient(ctx, config)
+		if err != nil {
+			return fmt.Errorf("configuring AWS client (%s): %w", region, err)
+		}
+
+		clients = append(clients, client)
+	}
+
+	resourceTypes, err := discover.ResourceTypes(ctx, clients[0], splitList(*services)...)
+	if err != nil {
+		return err
+	}
+
+	resources, err := discover.Resources(ctx, clients, resourceTypes, tags)
+	if err != nil {
+		// Report listing errors but still write the resources that were found.
+		fmt.Fprintf(os.Stderr, "warning: %s\n", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	warnings, err := discover.WriteImportBlocks(w, resources, clients[0].Region)
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+	if err != nil {
+		return fmt.Errorf("writing import blocks: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Discovered %d resources of %d resource types\n", len(resources), len(resourceTypes))
+
+	return nil
+}
+
+func splitList(s string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+func parseKeyValues(s string) (map[string]string, error) {
+	m := make(map[string]string)
+
+	for _, v := range splitList(s) {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid key=value pair: %q", v)
+		}
+
+		m[key] = value
+	}
+
+	return m, nil
+}